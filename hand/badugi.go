@@ -0,0 +1,96 @@
+package hand
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Badugi configures NewHand to select the best Badugi hand.  A Badugi hand
+// is the largest set of at most four cards with no two cards sharing a
+// rank or a suit.  More cards always beat fewer cards and hands of the
+// same size are compared as aces low lowball, so Badugi hands are sorted
+// with SortingLow.  The ranking of a Badugi hand is always HighCard.
+func Badugi(c *Config) {
+	c.sorting = SortingLow
+	c.aceIsLow = true
+	c.ignoreStraights = true
+	c.ignoreFlushes = true
+	c.badugi = true
+}
+
+// badugiNumbers are the names of the number of cards in a Badugi hand.
+var badugiNumbers = []string{"zero", "one", "two", "three", "four"}
+
+// badugiHand returns the best Badugi hand of the cards such as
+// "three-card 7-5-2".
+func badugiHand(cards []*Card, c Config) *Hand {
+	var best []*Card
+	bestStrength := 0
+	max := len(cards)
+	if max > 4 {
+		max = 4
+	}
+	for n := max; n > 0 && best == nil; n-- {
+		for _, combo := range indexCombos(len(cards), n) {
+			selected := make([]*Card, n)
+			for i, index := range combo {
+				selected[i] = cards[index]
+			}
+			if !isBadugi(selected) {
+				continue
+			}
+			s := badugiStrength(selected, c)
+			if best == nil || s < bestStrength {
+				best, bestStrength = selected, s
+			}
+		}
+	}
+	if best == nil {
+		best, bestStrength = []*Card{}, badugiStrength(nil, c)
+	}
+
+	sort.Sort(sort.Reverse(byAceLow(best)))
+	ranks := []string{}
+	for _, card := range best {
+		ranks = append(ranks, card.Rank().String())
+	}
+	return &Hand{
+		ranking:     HighCard,
+		cards:       best,
+		description: fmt.Sprintf("%s-card %s", badugiNumbers[len(best)], strings.Join(ranks, "-")),
+		strength:    bestStrength,
+	}
+}
+
+// isBadugi returns true if no two of the cards share a rank or a suit.
+func isBadugi(cards []*Card) bool {
+	for i, c1 := range cards {
+		for _, c2 := range cards[i+1:] {
+			if c1.Rank() == c2.Rank() || c1.Suit() == c2.Suit() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// badugiStrength packs the number of missing cards followed by the rank
+// codes of the cards from highest to lowest, so the lowest strength is
+// the best Badugi hand.
+func badugiStrength(cards []*Card, c Config) int {
+	codes := []int{}
+	for _, card := range cards {
+		codes = append(codes, card.Rank().code(c))
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(codes)))
+
+	s := 4 - len(codes)
+	for i := 0; i < 4; i++ {
+		s <<= 4
+		if i < len(codes) {
+			s |= codes[i]
+		}
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+var badugiTests = []struct {
+	cards       []*Card
+	arrangement []*Card
+	description string
+}{
+	{
+		pokertest.Cards("4s", "3h", "2d", "Ac"),
+		pokertest.Cards("4s", "3h", "2d", "Ac"),
+		"four-card 4-3-2-A",
+	},
+	{
+		pokertest.Cards("7s", "5h", "2d", "2c"),
+		pokertest.Cards("7s", "5h", "2d"),
+		"three-card 7-5-2",
+	},
+	{
+		pokertest.Cards("7s", "5s", "2d", "Kh"),
+		pokertest.Cards("Kh", "5s", "2d"),
+		"three-card K-5-2",
+	},
+	{
+		pokertest.Cards("8s", "5s", "3s", "As"),
+		pokertest.Cards("As"),
+		"one-card A",
+	},
+	{
+		pokertest.Cards("Ks", "Kh", "2s", "2h"),
+		pokertest.Cards("Ks", "2h"),
+		"two-card K-2",
+	},
+}
+
+func TestBadugi(t *testing.T) {
+	for _, test := range badugiTests {
+		h := New(test.cards, Badugi)
+		if h.Description() != test.description {
+			t.Fatalf("New(%v).Description() = %q; want %q", test.cards, h.Description(), test.description)
+		}
+		if len(h.Cards()) != len(test.arrangement) {
+			t.Fatalf("New(%v).Cards() = %v; want %v", test.cards, h.Cards(), test.arrangement)
+		}
+		for i, c := range h.Cards() {
+			if *c != *test.arrangement[i] {
+				t.Fatalf("New(%v).Cards() = %v; want %v", test.cards, h.Cards(), test.arrangement)
+			}
+		}
+		if h.Ranking() != HighCard {
+			t.Fatalf("New(%v).Ranking() = %v; want %v", test.cards, h.Ranking(), HighCard)
+		}
+	}
+}
+
+func TestBadugiOrder(t *testing.T) {
+	hands := []*Hand{
+		New(pokertest.Cards("Ks", "Qh", "Jd", "Tc"), Badugi),
+		New(pokertest.Cards("7s", "5h", "2d", "2c"), Badugi),
+		New(pokertest.Cards("4s", "3h", "2d", "Ac"), Badugi),
+		New(pokertest.Cards("7s", "4h", "2d", "2c"), Badugi),
+		New(pokertest.Cards("8s", "5s", "3s", "As"), Badugi),
+	}
+	sorted := Sort(SortingLow, DESC, hands...)
+	for i, j := range []int{2, 0, 3, 1, 4} {
+		if sorted[i] != hands[j] {
+			t.Fatalf("Sort() = %v; want %v at %d", sorted[i], hands[j], i)
+		}
+	}
+
+	h1 := New(pokertest.Cards("7s", "5h", "2d", "2c"), Badugi)
+	h2 := New(pokertest.Cards("7h", "5s", "2c", "2h"), Badugi)
+	if h1.CompareTo(h2) != 0 {
+		t.Fatalf("%v.CompareTo(%v) = %d; want 0", h1, h2, h1.CompareTo(h2))
+	}
+	if s := Evaluate(pokertest.Cards("7s", "5h", "2d", "2c"), Badugi); s != h1.Strength() {
+		t.Fatalf("Evaluate() = %d; want %d", s, h1.Strength())
+	}
+}
@@ -0,0 +1,244 @@
+package hand
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A StartingHand is one of the 169 classes of two hole cards that are the
+// same up to suit, such as "AKs", "72o" or "TT".  Starting hands are
+// numbered from 0 to 168 by their position in the usual 13 by 13 grid
+// with aces first: pairs on the diagonal, suited hands above it and
+// offsuit hands below it.
+type StartingHand int
+
+// NumStartingHands is the number of starting hands.
+const NumStartingHands = 169
+
+// NewStartingHand returns the starting hand of two hole cards.  An error
+// is returned if there aren't two different cards or one is a joker.
+func NewStartingHand(holeCards []*Card) (StartingHand, error) {
+	if len(holeCards) != 2 {
+		return 0, fmt.Errorf("hand: starting hand requires two hole cards but has %d", len(holeCards))
+	}
+	c1, c2 := holeCards[0], holeCards[1]
+	if c1.Index() == -1 || c2.Index() == -1 || c1.Index() == c2.Index() {
+		return 0, fmt.Errorf("hand: hole cards %v and %v aren't a starting hand", c1, c2)
+	}
+	h := rangeHand{hi: c1.Rank().indexOf(), lo: c2.Rank().indexOf()}
+	if h.hi < h.lo {
+		h.hi, h.lo = h.lo, h.hi
+	}
+	if !h.pair() {
+		h.suited = 'o'
+		if c1.Suit() == c2.Suit() {
+			h.suited = 's'
+		}
+	}
+	return startingHand(h), nil
+}
+
+// ParseStartingHand parses a starting hand such as "AKs", "72o" or "TT".
+func ParseStartingHand(s string) (StartingHand, error) {
+	h, ok := parseRangeClass(s)
+	if !ok || (!h.pair() && h.suited == 0) {
+		return 0, fmt.Errorf("hand: starting hand %q is invalid", s)
+	}
+	return startingHand(h), nil
+}
+
+// StartingHands returns every starting hand in order.
+func StartingHands() []StartingHand {
+	hands := make([]StartingHand, NumStartingHands)
+	for i := range hands {
+		hands[i] = StartingHand(i)
+	}
+	return hands
+}
+
+func startingHand(h rangeHand) StartingHand {
+	row, col := Ace.indexOf()-h.hi, Ace.indexOf()-h.lo
+	if h.suited == 'o' {
+		row, col = col, row
+	}
+	return StartingHand(row*13 + col)
+}
+
+func (s StartingHand) rangeHand() rangeHand {
+	row, col := int(s)/13, int(s)%13
+	h := rangeHand{hi: Ace.indexOf() - row, lo: Ace.indexOf() - col}
+	switch {
+	case row < col:
+		h.suited = 's'
+	case row > col:
+		h.hi, h.lo = h.lo, h.hi
+		h.suited = 'o'
+	}
+	return h
+}
+
+// Ranks returns the higher and lower rank of the starting hand.
+func (s StartingHand) Ranks() (high, low Rank) {
+	h := s.rangeHand()
+	return allRanks()[h.hi], allRanks()[h.lo]
+}
+
+// Pair returns true if the starting hand is a pocket pair.
+func (s StartingHand) Pair() bool {
+	return s.rangeHand().pair()
+}
+
+// Suited returns true if the starting hand is two cards of the same suit.
+func (s StartingHand) Suited() bool {
+	return s.rangeHand().suited == 's'
+}
+
+// Combos returns every combination of hole cards in the starting hand:
+// six for a pair, four suited or twelve offsuit.
+func (s StartingHand) Combos() [][]*Card {
+	return s.rangeHand().combos()
+}
+
+// String returns the starting hand such as "AKs", "72o" or "TT".
+func (s StartingHand) String() string {
+	h := s.rangeHand()
+	str := string(allRanks()[h.hi]) + string(allRanks()[h.lo])
+	if h.suited != 0 {
+		str += string(h.suited)
+	}
+	return str
+}
+
+// Equity returns the precomputed percentage of the pot the starting hand
+// wins all in preflop against a random hand.
+func (s StartingHand) Equity() float64 {
+	return float64(preflopEquity[s]) / 100
+}
+
+// EquityVs returns the precomputed percentage of the pot the starting
+// hand wins all in preflop against the other starting hand, averaged
+// over every combination of the two that don't share a card.
+func (s StartingHand) EquityVs(o StartingHand) float64 {
+	switch {
+	case s == o:
+		return 50
+	case s < o:
+		return float64(preflopMatchups[matchupIndex(s, o)]) / 100
+	}
+	return 100 - float64(preflopMatchups[matchupIndex(o, s)])/100
+}
+
+// matchupIndex returns the position of the matchup of s and o, where s is
+// less than o, in the upper triangle of the matchup table.
+func matchupIndex(s, o StartingHand) int {
+	i, j := int(s), int(o)
+	return i*NumStartingHands - i*(i+1)/2 + j - i - 1
+}
+
+// Canonical returns the hole cards and board with their suits renamed so
+// that hands which are the same up to suit, such as A♥K♥ on 7♥2♣3♣ and
+// A♦K♦ on 7♦2♠3♠, have the same cards.  Each group is sorted by rank from
+// highest to lowest and suits are chosen in the order spades, hearts,
+// diamonds and clubs, so A♥K♥ becomes A♠K♠.  Jokers are kept as they are.
+func Canonical(holeCards, board []*Card) ([]*Card, []*Card) {
+	var bestHole, bestBoard []*Card
+	var bestKey []int
+	for _, perm := range suitPermutations {
+		hole := renameSuits(holeCards, perm)
+		b := renameSuits(board, perm)
+		key := suitKey(hole, b)
+		if bestKey == nil || lessKey(key, bestKey) {
+			bestHole, bestBoard, bestKey = hole, b, key
+		}
+	}
+	return bestHole, bestBoard
+}
+
+// A FlopClass is a flop that is the same up to suit as a number of other
+// flops.
+type FlopClass struct {
+	// Cards is the canonical flop returned by Canonical.
+	Cards []*Card
+
+	// Flops is the number of flops in the class.
+	Flops int
+}
+
+// FlopClasses returns the 1,755 classes of the 22,100 flops from the
+// highest cards down.
+func FlopClasses() []FlopClass {
+	classes := []FlopClass{}
+	index := map[CardSet]int{}
+	for _, combo := range indexCombos(52, 3) {
+		flop := []*Card{}
+		for _, i := range combo {
+			flop = append(flop, indexedCards[51-i])
+		}
+		_, canonical := Canonical(nil, flop)
+		set := NewCardSet(canonical...)
+		if i, ok := index[set]; ok {
+			classes[i].Flops++
+			continue
+		}
+		index[set] = len(classes)
+		classes = append(classes, FlopClass{Cards: canonical, Flops: 1})
+	}
+	return classes
+}
+
+// suitPermutations holds the 24 ways suits can be renamed as suit index
+// permutations.
+var suitPermutations = func() [][4]int {
+	perms := [][4]int{}
+	for a := 0; a < 4; a++ {
+		for b := 0; b < 4; b++ {
+			for c := 0; c < 4; c++ {
+				d := 6 - a - b - c
+				if a != b && a != c && b != c && d != a && d != b && d != c {
+					perms = append(perms, [4]int{a, b, c, d})
+				}
+			}
+		}
+	}
+	return perms
+}()
+
+// renameSuits returns the cards with each suit renamed by the permutation
+// sorted by rank from highest to lowest and then by suit.
+func renameSuits(cards []*Card, perm [4]int) []*Card {
+	renamed := make([]*Card, len(cards))
+	for i, c := range cards {
+		renamed[i] = c
+		if c.Index() != -1 {
+			renamed[i] = CardForIndex(c.Rank().indexOf()*4 + perm[c.Suit().index()])
+		}
+	}
+	sort.SliceStable(renamed, func(i, j int) bool {
+		ri, rj := renamed[i].Rank().indexOf(), renamed[j].Rank().indexOf()
+		if ri != rj {
+			return ri > rj
+		}
+		return renamed[i].Suit().index() < renamed[j].Suit().index()
+	})
+	return renamed
+}
+
+// suitKey returns the suit indexes of the hole cards followed by those of
+// the board.  Ranks don't need to be compared since renaming suits
+// doesn't change them.
+func suitKey(holeCards, board []*Card) []int {
+	key := []int{}
+	for _, c := range append(append([]*Card{}, holeCards...), board...) {
+		key = append(key, c.Suit().index())
+	}
+	return key
+}
+
+func lessKey(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
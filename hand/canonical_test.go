@@ -0,0 +1,137 @@
+package hand_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+func TestStartingHand(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		cards  []*Card
+		want   string
+		combos int
+	}{
+		{pokertest.Cards("Kh", "Ah"), "AKs", 4},
+		{pokertest.Cards("2c", "7d"), "72o", 12},
+		{pokertest.Cards("Ts", "Td"), "TT", 6},
+	}
+	for _, test := range tests {
+		s, err := NewStartingHand(test.cards)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.String() != test.want {
+			t.Errorf("NewStartingHand(%v) = %v; want %v", test.cards, s, test.want)
+		}
+		if n := len(s.Combos()); n != test.combos {
+			t.Errorf("%v has %d combos; want %d", s, n, test.combos)
+		}
+		parsed, err := ParseStartingHand(test.want)
+		if err != nil || parsed != s {
+			t.Errorf("ParseStartingHand(%q) = %v, %v; want %v", test.want, parsed, err, s)
+		}
+	}
+
+	if _, err := NewStartingHand(pokertest.Cards("As", "As")); err == nil {
+		t.Error("NewStartingHand should reject the same card twice")
+	}
+	if _, err := ParseStartingHand("AK"); err == nil {
+		t.Error("ParseStartingHand should require suited or offsuit")
+	}
+}
+
+func TestStartingHands(t *testing.T) {
+	t.Parallel()
+	combos := 0
+	seen := map[string]bool{}
+	for _, s := range StartingHands() {
+		if seen[s.String()] {
+			t.Fatalf("%v appears twice", s)
+		}
+		seen[s.String()] = true
+		for _, c := range s.Combos() {
+			if got, _ := NewStartingHand(c); got != s {
+				t.Fatalf("combo %v of %v is %v", c, s, got)
+			}
+		}
+		combos += len(s.Combos())
+	}
+	if combos != 1326 {
+		t.Fatalf("starting hands have %d combos; want 1326", combos)
+	}
+}
+
+func TestStartingHandEquity(t *testing.T) {
+	t.Parallel()
+	equityTests := []struct {
+		hand   string
+		equity float64
+	}{
+		{"AA", 85.2},
+		{"AKs", 67.0},
+		{"72o", 34.6},
+	}
+	for _, test := range equityTests {
+		s, _ := ParseStartingHand(test.hand)
+		if e := s.Equity(); math.Abs(e-test.equity) > 0.5 {
+			t.Errorf("%v equity = %.2f; want about %.1f", s, e, test.equity)
+		}
+	}
+
+	matchupTests := []struct {
+		hand, other string
+		equity      float64
+	}{
+		{"AA", "KK", 82.0},
+		{"QQ", "AKs", 54.0},
+		{"AKo", "22", 47.0},
+	}
+	for _, test := range matchupTests {
+		s, _ := ParseStartingHand(test.hand)
+		o, _ := ParseStartingHand(test.other)
+		if e := s.EquityVs(o); math.Abs(e-test.equity) > 1 {
+			t.Errorf("%v vs %v equity = %.2f; want about %.1f", s, o, e, test.equity)
+		}
+		if sum := s.EquityVs(o) + o.EquityVs(s); math.Abs(sum-100) > 0.001 {
+			t.Errorf("%v and %v equities sum to %.2f", s, o, sum)
+		}
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	t.Parallel()
+	hole1, board1 := Canonical(pokertest.Cards("Kh", "Ah"), pokertest.Cards("7h", "2c", "3c"))
+	hole2, board2 := Canonical(pokertest.Cards("Ad", "Kd"), pokertest.Cards("3s", "7d", "2s"))
+	if !reflect.DeepEqual(hole1, hole2) || !reflect.DeepEqual(board1, board2) {
+		t.Fatalf("Canonical() = %v %v and %v %v; want equal", hole1, board1, hole2, board2)
+	}
+	if want := pokertest.Cards("As", "Ks"); !reflect.DeepEqual(hole1, want) {
+		t.Errorf("canonical hole cards = %v; want %v", hole1, want)
+	}
+	if want := pokertest.Cards("7s", "3h", "2h"); !reflect.DeepEqual(board1, want) {
+		t.Errorf("canonical board = %v; want %v", board1, want)
+	}
+}
+
+func TestFlopClasses(t *testing.T) {
+	t.Parallel()
+	classes := FlopClasses()
+	if len(classes) != 1755 {
+		t.Fatalf("FlopClasses() returned %d classes; want 1755", len(classes))
+	}
+	flops := 0
+	for _, c := range classes {
+		flops += c.Flops
+	}
+	if flops != 22100 {
+		t.Fatalf("flop classes have %d flops; want 22100", flops)
+	}
+	if want := pokertest.Cards("As", "Ah", "Ad"); !reflect.DeepEqual(classes[0].Cards, want) || classes[0].Flops != 4 {
+		t.Fatalf("first class = %v with %d flops; want %v with 4", classes[0].Cards, classes[0].Flops, want)
+	}
+}
@@ -1,10 +1,5 @@
 package hand
 
-import (
-	"errors"
-	"strings"
-)
-
 // A Rank represents the rank of a card.
 type Rank string
 
@@ -47,15 +42,41 @@ const (
 
 	// Ace has the rank of A
 	Ace Rank = "A"
+
+	// Joker is the rank of a joker, which has no suit.
+	Joker Rank = "🃏"
 )
 
 // IndexOf returns the index of the rank in the ascending order of ranks.
 // IndexOf returns -1 if the rank is not found.
 func (r Rank) indexOf() int {
-	for i, rank := range allRanks() {
-		if r == rank {
-			return i
-		}
+	switch r {
+	case Two:
+		return 0
+	case Three:
+		return 1
+	case Four:
+		return 2
+	case Five:
+		return 3
+	case Six:
+		return 4
+	case Seven:
+		return 5
+	case Eight:
+		return 6
+	case Nine:
+		return 7
+	case Ten:
+		return 8
+	case Jack:
+		return 9
+	case Queen:
+		return 10
+	case King:
+		return 11
+	case Ace:
+		return 12
 	}
 	return -1
 }
@@ -81,14 +102,24 @@ func (r Rank) valid() bool {
 }
 
 func (r Rank) aceLowIndexOf() int {
-	for i, rank := range allAceLowRanks() {
-		if r == rank {
-			return i
-		}
+	if r == Ace {
+		return 0
+	}
+	if i := r.indexOf(); i != -1 {
+		return i + 1
 	}
 	return -1
 }
 
+// code returns the rank's position in the configuration's rank order
+// starting from one.  Blank cards have a code of zero.
+func (r Rank) code(c Config) int {
+	if c.aceIsLow {
+		return r.aceLowIndexOf() + 1
+	}
+	return r.indexOf() + 1
+}
+
 type byAceHighRank []Rank
 
 func (a byAceHighRank) Len() int { return len(a) }
@@ -164,13 +195,35 @@ const (
 	Clubs Suit = "♣"
 )
 
-// String returns a string in the format "♠"
+// String returns a string in the format "♠".
 func (s Suit) String() string {
 	return string(s)
 }
 
+// Format returns the suit as a string in the given notation such as "♠"
+// or "s".
+func (s Suit) Format(n Notation) string {
+	if n == ASCII {
+		switch s {
+		case Spades:
+			return "s"
+		case Hearts:
+			return "h"
+		case Diamonds:
+			return "d"
+		case Clubs:
+			return "c"
+		}
+	}
+	return string(s)
+}
+
 func (s Suit) valid() bool {
-	return strings.Contains("♠♥♦♣", string(s))
+	switch s {
+	case Spades, Hearts, Diamonds, Clubs:
+		return true
+	}
+	return false
 }
 
 // A Card represents a playing card in the game of poker.  It is composed of a rank and suit.
@@ -189,41 +242,57 @@ func (c *Card) Suit() Suit {
 	return c.TheSuit
 }
 
-// String returns a string in the format "4♠"
+// IsJoker returns true if the card is a joker.
+func (c *Card) IsJoker() bool {
+	return c.Rank() == Joker
+}
+
+// String returns a string in the format "4♠".  A joker is "🃏".
 func (c *Card) String() string {
-	return string(c.Rank()) + string(c.Suit())
+	return c.Format(Unicode)
+}
+
+// Format returns the card as a string in the given notation such as "4♠"
+// or "4s".  A joker is "Jk" in the ASCII notation.
+func (c *Card) Format(n Notation) string {
+	if c.IsJoker() && n == ASCII {
+		return "Jk"
+	}
+	return string(c.Rank()) + c.Suit().Format(n)
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
 // The text format is "4♠".
 func (c *Card) MarshalText() ([]byte, error) {
-	return []byte(c.String()), nil
+	return []byte(c.Format(Unicode)), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
-// The card is expected to be in the format "4♠".
+// The card may be in any format accepted by ParseCard such as "4♠".
 func (c *Card) UnmarshalText(text []byte) error {
-	var rank Rank
-	var suit Suit
-	const errStr = `card: serialization should be of the format "4♠"`
-	for i, c := range string(text) {
-		if i == 0 && Rank(c).valid() {
-			rank = Rank(c)
-		} else if i == 1 && Suit(c).valid() {
-			suit = Suit(c)
-		} else {
-			return errors.New(errStr)
-		}
-	}
-	if rank == "" || suit == "" {
-		return errors.New(errStr)
+	card, err := ParseCard(string(text))
+	if err != nil {
+		return err
 	}
-	c.TheRank = rank
-	c.TheSuit = suit
+	*c = *card
 	return nil
 }
 
+// A Notation is the way cards are written as strings.  Serialization
+// always uses the Unicode notation.
+type Notation int
+
+const (
+	// Unicode writes cards with suit symbols such as "A♠".
+	Unicode Notation = iota
+
+	// ASCII writes cards with suit letters such as "As".
+	ASCII
+)
+
 var (
+	JokerCard = &Card{TheRank: Joker}
+
 	AceSpades   = &Card{TheRank: Ace, TheSuit: Spades}
 	KingSpades  = &Card{TheRank: King, TheSuit: Spades}
 	QueenSpades = &Card{TheRank: Queen, TheSuit: Spades}
@@ -281,8 +350,31 @@ var (
 	TwoClubs   = &Card{TheRank: Two, TheSuit: Clubs}
 )
 
-// Cards returns all 52 unshuffled cards
-func Cards() []*Card {
+// Cards returns the unshuffled cards of the deck configured by the
+// options.  Without options all 52 cards are returned.
+func Cards(options ...func(*Config)) []*Card {
+	c := newConfig(options)
+	cards := []*Card{}
+	for _, card := range allCards() {
+		if c.inDeck(card) {
+			cards = append(cards, card)
+		}
+	}
+	for i := 0; i < c.jokers; i++ {
+		cards = append(cards, JokerCard)
+	}
+	return cards
+}
+
+// inDeck returns true if the card is part of the configuration's deck.
+func (c Config) inDeck(card *Card) bool {
+	if card.IsJoker() || c.lowestRank == "" {
+		return true
+	}
+	return card.Rank().indexOf() >= c.lowestRank.indexOf()
+}
+
+func allCards() []*Card {
 	return []*Card{
 		AceSpades, KingSpades, QueenSpades, JackSpades, TenSpades,
 		NineSpades, EightSpades, SevenSpades, SixSpades, FiveSpades,
@@ -350,11 +442,6 @@ func allRanks() []Rank {
 		Nine, Ten, Jack, Queen, King, Ace}
 }
 
-func allAceLowRanks() []Rank {
-	return []Rank{Ace, Two, Three, Four, Five, Six, Seven, Eight,
-		Nine, Ten, Jack, Queen, King}
-}
-
 func allSuits() []Suit {
 	return []Suit{Spades, Hearts, Diamonds, Clubs}
 }
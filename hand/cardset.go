@@ -0,0 +1,142 @@
+package hand
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Index returns the card's index from 0 to 51.  Cards are indexed by
+// rank from two to ace and then by suit in the order spades, hearts,
+// diamonds and clubs, so 2♠ is 0, 2♥ is 1 and A♣ is 51.  Index returns
+// -1 for jokers and blank cards.
+func (c *Card) Index() int {
+	r := c.Rank().indexOf()
+	s := c.Suit().index()
+	if r == -1 || s == -1 {
+		return -1
+	}
+	return r*4 + s
+}
+
+// CardForIndex returns the card of the index returned by Index or nil if
+// the index is out of range.
+func CardForIndex(i int) *Card {
+	if i < 0 || i >= len(indexedCards) {
+		return nil
+	}
+	return indexedCards[i]
+}
+
+var indexedCards = func() (cards [52]*Card) {
+	for _, c := range allCards() {
+		cards[c.Index()] = c
+	}
+	return
+}()
+
+func (s Suit) index() int {
+	switch s {
+	case Spades:
+		return 0
+	case Hearts:
+		return 1
+	case Diamonds:
+		return 2
+	case Clubs:
+		return 3
+	}
+	return -1
+}
+
+// A CardSet is a set of cards with a bit for each card's Index.  The zero
+// value is the empty set.  Jokers and blank cards can't be in a set.
+type CardSet uint64
+
+// NewCardSet returns the set of the cards.  Cards without an index are
+// ignored.
+func NewCardSet(cards ...*Card) CardSet {
+	s := CardSet(0)
+	for _, c := range cards {
+		s = s.Add(c)
+	}
+	return s
+}
+
+// Add returns the set with the card added.
+func (s CardSet) Add(c *Card) CardSet {
+	if i := c.Index(); i != -1 {
+		s |= 1 << uint(i)
+	}
+	return s
+}
+
+// Remove returns the set without the card.
+func (s CardSet) Remove(c *Card) CardSet {
+	if i := c.Index(); i != -1 {
+		s &^= 1 << uint(i)
+	}
+	return s
+}
+
+// Contains returns true if the card is in the set.
+func (s CardSet) Contains(c *Card) bool {
+	i := c.Index()
+	return i != -1 && s&(1<<uint(i)) != 0
+}
+
+// Union returns the cards in either set.
+func (s CardSet) Union(o CardSet) CardSet {
+	return s | o
+}
+
+// Intersect returns the cards in both sets.
+func (s CardSet) Intersect(o CardSet) CardSet {
+	return s & o
+}
+
+// Difference returns the cards in the set that aren't in the other set.
+func (s CardSet) Difference(o CardSet) CardSet {
+	return s &^ o
+}
+
+// Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Each calls f with each card of the set in index order.
+func (s CardSet) Each(f func(*Card)) {
+	for s != 0 {
+		i := bits.TrailingZeros64(uint64(s))
+		f(indexedCards[i])
+		s &= s - 1
+	}
+}
+
+// Cards returns the cards of the set in index order.
+func (s CardSet) Cards() []*Card {
+	cards := make([]*Card, 0, s.Count())
+	s.Each(func(c *Card) {
+		cards = append(cards, c)
+	})
+	return cards
+}
+
+// Deck returns an unshuffled deck of the cards of the set.
+func (s CardSet) Deck() *Deck {
+	return &Deck{Cards: s.Cards()}
+}
+
+// String returns the cards of the set separated by commas.
+func (s CardSet) String() string {
+	strs := []string{}
+	s.Each(func(c *Card) {
+		strs = append(strs, c.String())
+	})
+	return strings.Join(strs, ",")
+}
+
+// CardSet returns the set of the cards remaining in the deck.
+func (d *Deck) CardSet() CardSet {
+	return NewCardSet(d.Cards...)
+}
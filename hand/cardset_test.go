@@ -0,0 +1,78 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+func TestCardIndex(t *testing.T) {
+	seen := map[int]bool{}
+	for _, c := range Cards() {
+		i := c.Index()
+		if i < 0 || i > 51 || seen[i] {
+			t.Fatalf("%v.Index() = %d; want a unique index from 0 to 51", c, i)
+		}
+		seen[i] = true
+		if CardForIndex(i) != c {
+			t.Fatalf("CardForIndex(%d) = %v; want %v", i, CardForIndex(i), c)
+		}
+	}
+	if i := TwoSpades.Index(); i != 0 {
+		t.Fatalf("TwoSpades.Index() = %d; want 0", i)
+	}
+	if i := AceClubs.Index(); i != 51 {
+		t.Fatalf("AceClubs.Index() = %d; want 51", i)
+	}
+	if i := JokerCard.Index(); i != -1 {
+		t.Fatalf("JokerCard.Index() = %d; want -1", i)
+	}
+	if c := CardForIndex(52); c != nil {
+		t.Fatalf("CardForIndex(52) = %v; want nil", c)
+	}
+}
+
+func TestCardSet(t *testing.T) {
+	s1 := NewCardSet(pokertest.Cards("As", "Kd", "2c")...)
+	s2 := NewCardSet(pokertest.Cards("Kd", "Qh")...)
+
+	if n := s1.Count(); n != 3 {
+		t.Fatalf("Count() = %d; want 3", n)
+	}
+	if !s1.Contains(AceSpades) || s1.Contains(QueenHearts) {
+		t.Fatalf("%v.Contains() is wrong", s1)
+	}
+	if u := s1.Union(s2); u.Count() != 4 || !u.Contains(QueenHearts) {
+		t.Fatalf("Union() = %v", u)
+	}
+	if i := s1.Intersect(s2); i != NewCardSet(KingDiamonds) {
+		t.Fatalf("Intersect() = %v; want %v", i, NewCardSet(KingDiamonds))
+	}
+	if d := s1.Difference(s2); d != NewCardSet(AceSpades, TwoClubs) {
+		t.Fatalf("Difference() = %v", d)
+	}
+	if r := s1.Remove(AceSpades).Add(QueenHearts); r != NewCardSet(KingDiamonds, TwoClubs, QueenHearts) {
+		t.Fatalf("Remove().Add() = %v", r)
+	}
+
+	cards := s1.Cards()
+	want := []*Card{TwoClubs, KingDiamonds, AceSpades}
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Fatalf("Cards() = %v; want %v", cards, want)
+		}
+	}
+	if s := s1.String(); s != "2♣,K♦,A♠" {
+		t.Fatalf("String() = %q; want %q", s, "2♣,K♦,A♠")
+	}
+
+	deck := NewDealer().Deck()
+	all := deck.CardSet()
+	if all.Count() != 52 {
+		t.Fatalf("deck CardSet().Count() = %d; want 52", all.Count())
+	}
+	if d := all.Deck(); len(d.Cards) != 52 || d.CardSet() != all {
+		t.Fatalf("CardSet().Deck() = %v", d)
+	}
+}
@@ -1,9 +1,13 @@
 package hand
 
 import (
+	"bufio"
+	crand "crypto/rand"
+	"encoding/binary"
+	"io"
 	"math/rand"
 	"strings"
-	"time"
+	"sync"
 )
 
 // Deck is a slice of cards used for dealing
@@ -33,22 +37,52 @@ func (d *Deck) ShowCards(start, end int) []*Card {
 	return d.Cards[start:end]
 }
 
+// Shuffle shuffles the cards left in the deck with the rng, such as when
+// the discards of a draw game are shuffled into a new stub.
+func (d *Deck) Shuffle(rng RNG) {
+	d.Cards = shuffleCards(d.Cards, rng)
+}
+
+// Reduce removes the cards that aren't part of the deck configured by
+// the options, such as the twos through fives for ShortDeck.  The order
+// of the remaining cards is kept.
+func (d *Deck) Reduce(options ...func(*Config)) {
+	c := newConfig(options)
+	cards := []*Card{}
+	for _, card := range d.Cards {
+		if c.inDeck(card) {
+			cards = append(cards, card)
+		}
+	}
+	d.Cards = cards
+}
+
 // String implements the fmt.Stringer interface
 func (d *Deck) String() string {
+	return d.Format(Unicode)
+}
+
+// Format returns the cards of the deck separated by commas in the given
+// notation.
+func (d *Deck) Format(n Notation) string {
 	s := []string{}
 	for _, c := range d.Cards {
-		s = append(s, c.String())
+		s = append(s, c.Format(n))
 	}
 	return strings.Join(s, ",")
 }
 
 // MarshalText implements the encoding.TextMarshaler interface
 func (d *Deck) MarshalText() (text []byte, err error) {
-	return []byte(d.String()), nil
+	return []byte(d.Format(Unicode)), nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface
 func (d *Deck) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		d.Cards = []*Card{}
+		return nil
+	}
 	strs := strings.Split(string(text), ",")
 	cards := make([]*Card, len(strs))
 	for i, s := range strs {
@@ -67,24 +101,107 @@ type Dealer interface {
 	Deck() *Deck
 }
 
-// NewDealer returns a dealer that generates shuffled decks.
-func NewDealer() Dealer {
-	return dealer{}
+// A Shuffler is a Dealer that also shuffles the cards left in a deck it
+// dealt, such as when the discards of a draw game are shuffled into a new
+// stub.  The dealers of NewDealer, NewRNGDealer and NewFairDealer are
+// Shufflers.
+type Shuffler interface {
+	Shuffle(deck *Deck)
+}
+
+// An RNG is a source of random numbers used to shuffle decks.
+// *math/rand.Rand implements RNG.
+type RNG interface {
+	// Intn returns a uniformly distributed number in [0, n).
+	Intn(n int) int
+}
+
+// NewDealer returns a dealer that generates decks of the cards configured
+// by the options, such as ShortDeck, shuffled with CryptoRNG.
+func NewDealer(options ...func(*Config)) Dealer {
+	return NewRNGDealer(CryptoRNG(), options...)
+}
+
+// NewRNGDealer returns a dealer that generates decks of the cards
+// configured by the options shuffled with the rng.  The rng must be safe
+// for concurrent use if the dealer is.
+func NewRNGDealer(rng RNG, options ...func(*Config)) Dealer {
+	return dealer{rng: rng, options: options}
+}
+
+// NewSeededDealer returns a dealer that generates the same sequence of
+// decks for the same seed, for reproducible simulations and bug reports.
+// It must not be used for real money play.
+func NewSeededDealer(seed int64, options ...func(*Config)) Dealer {
+	rng := &lockedRNG{r: rand.New(rand.NewSource(seed))}
+	return NewRNGDealer(rng, options...)
 }
 
-type dealer struct{}
+type dealer struct {
+	rng     RNG
+	options []func(*Config)
+}
 
 func (d dealer) Deck() *Deck {
-	cards := shuffleCards(Cards())
+	cards := shuffleCards(Cards(d.options...), d.rng)
 	return &Deck{Cards: cards}
 }
 
-func shuffleCards(cards []*Card) []*Card {
-	rand.Seed(time.Now().UTC().UnixNano())
-	dest := []*Card{}
-	perm := rand.Perm(len(cards))
-	for _, v := range perm {
-		dest = append(dest, cards[v])
+// Shuffle implements the Shuffler interface.
+func (d dealer) Shuffle(deck *Deck) {
+	deck.Shuffle(d.rng)
+}
+
+// shuffleCards shuffles the cards in place with the Fisher-Yates shuffle.
+func shuffleCards(cards []*Card, rng RNG) []*Card {
+	for i := len(cards) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		cards[i], cards[j] = cards[j], cards[i]
 	}
-	return dest
+	return cards
+}
+
+// CryptoRNG returns an RNG backed by crypto/rand that is safe for
+// concurrent use.  It panics if the operating system's random source
+// fails.
+func CryptoRNG() RNG {
+	return cryptoRNG
+}
+
+var cryptoRNG = &lockedRNG{r: &readerRNG{r: bufio.NewReader(crand.Reader)}}
+
+// readerRNG returns uniform numbers from the bytes of a reader.
+type readerRNG struct {
+	r   io.Reader
+	buf [8]byte
+}
+
+// Intn returns a uniform number by rejecting the values of the top
+// partial multiple of n.
+func (s *readerRNG) Intn(n int) int {
+	if n <= 0 {
+		panic("hand: invalid argument to Intn")
+	}
+	max := ^uint64(0) - ^uint64(0)%uint64(n)
+	for {
+		if _, err := io.ReadFull(s.r, s.buf[:]); err != nil {
+			panic("hand: random source failed: " + err.Error())
+		}
+		v := binary.LittleEndian.Uint64(s.buf[:])
+		if v < max {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+// lockedRNG makes an RNG safe for concurrent use.
+type lockedRNG struct {
+	sync.Mutex
+	r RNG
+}
+
+func (l *lockedRNG) Intn(n int) int {
+	l.Lock()
+	defer l.Unlock()
+	return l.r.Intn(n)
 }
@@ -0,0 +1,96 @@
+package hand_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+)
+
+func TestSeededDealer(t *testing.T) {
+	d1 := NewSeededDealer(42)
+	d2 := NewSeededDealer(42)
+	d3 := NewSeededDealer(43)
+	for i := 0; i < 10; i++ {
+		deck1, deck2, deck3 := d1.Deck(), d2.Deck(), d3.Deck()
+		if deck1.String() != deck2.String() {
+			t.Fatalf("seeded decks differ: %v and %v", deck1, deck2)
+		}
+		if deck1.String() == deck3.String() {
+			t.Fatalf("decks of different seeds are equal: %v", deck1)
+		}
+		if deck1.CardSet().Count() != 52 {
+			t.Fatalf("deck %v doesn't have 52 distinct cards", deck1)
+		}
+	}
+}
+
+func TestDealerDecksDiffer(t *testing.T) {
+	d := NewDealer()
+	if d.Deck().String() == d.Deck().String() {
+		t.Fatal("two decks from the same dealer are equal")
+	}
+}
+
+func TestDeckShuffle(t *testing.T) {
+	deck := NewSeededDealer(7).Deck()
+	discards := &Deck{Cards: deck.PopMulti(10)}
+	before := discards.CardSet()
+	discards.Shuffle(CryptoRNG())
+	if len(discards.Cards) != 10 || discards.CardSet() != before {
+		t.Fatalf("Shuffle() changed the cards to %v", discards)
+	}
+}
+
+// TestShuffleChiSquare checks every card is equally likely at every
+// position of the deck.  The chi-square statistic of the 52x52 table of
+// card and position counts has (52-1)*(52-1) degrees of freedom, so it
+// should be within a few standard deviations of 2601.
+func TestShuffleChiSquare(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping chi-square test in short mode")
+	}
+	dealers := map[string]Dealer{
+		"crypto": NewDealer(),
+		"seeded": NewSeededDealer(1),
+	}
+	for name, d := range dealers {
+		const decks = 20000
+		var counts [52][52]int
+		for i := 0; i < decks; i++ {
+			for pos, c := range d.Deck().Cards {
+				counts[pos][c.Index()]++
+			}
+		}
+
+		expected := float64(decks) / 52
+		chiSquare := 0.0
+		for pos := range counts {
+			for _, n := range counts[pos] {
+				diff := float64(n) - expected
+				chiSquare += diff * diff / expected
+			}
+		}
+
+		df := 51.0 * 51.0
+		limit := df + 5*math.Sqrt(2*df)
+		if chiSquare > limit {
+			t.Errorf("%s dealer chi-square = %.1f; want less than %.1f", name, chiSquare, limit)
+		}
+	}
+}
+
+func TestEmptyDeckText(t *testing.T) {
+	deck := &Deck{Cards: []*Card{}}
+	b, err := deck.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deckCopy := &Deck{}
+	if err := deckCopy.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if len(deckCopy.Cards) != 0 {
+		t.Fatalf("UnmarshalText(%q) = %v; want an empty deck", b, deckCopy)
+	}
+}
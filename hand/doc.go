@@ -1,4 +1,5 @@
 //go:generate stringer -type=Ranking,Sorting,Ordering -output=stringer_autogen.go
+//go:generate go run gen_preflop.go
 
 /*
 Package hand implements poker hand evaluation and ranking.
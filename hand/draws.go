@@ -0,0 +1,277 @@
+package hand
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Draws is a set of the draws a player holds.
+type Draws int
+
+const (
+	// FlushDraw is four cards of a suit, at least one of them a hole card.
+	FlushDraw Draws = 1 << iota
+
+	// OpenEndedStraightDraw is a straight draw with two ranks that complete
+	// it.  Double gutshots are included because they have the same outs.
+	OpenEndedStraightDraw
+
+	// Gutshot is a straight draw with one rank that completes it.
+	Gutshot
+
+	// BackdoorFlushDraw is three cards of a suit on the flop, at least one
+	// of them a hole card.
+	BackdoorFlushDraw
+
+	// BackdoorStraightDraw is a straight that the turn and river can
+	// complete on the flop without a straight draw.
+	BackdoorStraightDraw
+
+	// Overcards is unpaired hole cards that are all higher than the board.
+	Overcards
+
+	// ComboDraw is a flush draw that is also a straight draw.
+	ComboDraw
+)
+
+var drawNames = []string{"flush draw", "open-ended straight draw", "gutshot",
+	"backdoor flush draw", "backdoor straight draw", "overcards", "combo draw"}
+
+// Has returns true if all of the draws d are in the set.
+func (ds Draws) Has(d Draws) bool {
+	return ds&d == d
+}
+
+// String returns the names of the draws separated by commas such as
+// "flush draw, gutshot, combo draw".
+func (ds Draws) String() string {
+	names := []string{}
+	for i, name := range drawNames {
+		if ds.Has(1 << uint(i)) {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// A DrawAnalysis is a player's current hand and draws.
+type DrawAnalysis struct {
+	// Hand is the current hand made from the hole cards and the board.
+	Hand *Hand
+
+	// Draws are the draws to a straight or flush that use a hole card.
+	Draws Draws
+
+	// Outs are the cards that complete a straight or flush draw.
+	Outs CardSet
+}
+
+// AnalyzeDraws classifies the hand and draws of hole cards on a partial
+// board in hold'em, where any number of hole cards may be used.  Draws
+// are only found on the flop and turn and never to a hand the player
+// already has.
+func AnalyzeDraws(holeCards, board []*Card) *DrawAnalysis {
+	cards := append(append([]*Card{}, holeCards...), board...)
+	a := &DrawAnalysis{Hand: New(cards)}
+	if len(board) < 3 || len(board) > 4 {
+		return a
+	}
+
+	used := NewCardSet(cards...)
+	boardRanks := rankMask(board)
+	ranks := rankMask(cards)
+
+	// flush draws
+	if a.Hand.Ranking() < Flush {
+		for _, s := range allSuits() {
+			n := countSuit(cards, s)
+			if countSuit(holeCards, s) == 0 {
+				continue
+			}
+			switch {
+			case n == 4:
+				a.Draws |= FlushDraw
+				for _, c := range allCards() {
+					if c.Suit() == s && !used.Contains(c) {
+						a.Outs = a.Outs.Add(c)
+					}
+				}
+			case n == 3 && len(board) == 3:
+				a.Draws |= BackdoorFlushDraw
+			}
+		}
+	}
+	if a.Draws.Has(FlushDraw) {
+		a.Draws &^= BackdoorFlushDraw
+	}
+
+	// straight draws
+	if a.Hand.Ranking() < Straight {
+		completing := 0
+		for _, r := range allRanks() {
+			add := rankBits(r)
+			if makesStraight(ranks|add, boardRanks|add) {
+				completing++
+				for _, c := range allCards() {
+					if c.Rank() == r && !used.Contains(c) {
+						a.Outs = a.Outs.Add(c)
+					}
+				}
+			}
+		}
+		switch {
+		case completing >= 2:
+			a.Draws |= OpenEndedStraightDraw
+		case completing == 1:
+			a.Draws |= Gutshot
+		case len(board) == 3 && hasBackdoorStraight(ranks, boardRanks):
+			a.Draws |= BackdoorStraightDraw
+		}
+	}
+
+	if a.Draws.Has(FlushDraw) && (a.Draws.Has(OpenEndedStraightDraw) || a.Draws.Has(Gutshot)) {
+		a.Draws |= ComboDraw
+	}
+
+	// overcards
+	over := len(holeCards) > 0 && a.Hand.Ranking() == HighCard
+	for _, h := range holeCards {
+		for _, b := range board {
+			over = over && h.Rank().indexOf() > b.Rank().indexOf()
+		}
+	}
+	if over {
+		a.Draws |= Overcards
+	}
+	return a
+}
+
+// A BoardTexture describes the board cards.
+type BoardTexture struct {
+	// Paired is true if two or more board cards share a rank.
+	Paired bool
+
+	// Monotone is true if every board card shares a suit.
+	Monotone bool
+
+	// TwoTone is true if the board cards have exactly two suits.
+	TwoTone bool
+
+	// Rainbow is true if no two board cards share a suit.
+	Rainbow bool
+
+	// FlushPossible is true if three or more board cards share a suit.
+	FlushPossible bool
+
+	// Connected is true if two hole cards can make a straight.
+	Connected bool
+
+	// HighCard is the rank of the highest board card.
+	HighCard Rank
+}
+
+// AnalyzeBoard describes the texture of the board.
+func AnalyzeBoard(board []*Card) *BoardTexture {
+	t := &BoardTexture{}
+	if len(board) == 0 {
+		return t
+	}
+
+	suits := 0
+	maxSuit := 0
+	for _, s := range allSuits() {
+		if n := countSuit(board, s); n > 0 {
+			suits++
+			if n > maxSuit {
+				maxSuit = n
+			}
+		}
+	}
+	t.Monotone = suits == 1 && len(board) > 1
+	t.TwoTone = suits == 2
+	t.Rainbow = maxSuit == 1
+	t.FlushPossible = maxSuit >= 3
+
+	ranks := rankMask(board)
+	t.Paired = bits.OnesCount16(ranks&^1) < len(board)
+	for _, w := range straightWindows {
+		if bits.OnesCount16(ranks&w) >= 3 {
+			t.Connected = true
+		}
+	}
+
+	t.HighCard = board[0].Rank()
+	for _, c := range board[1:] {
+		if c.Rank().indexOf() > t.HighCard.indexOf() {
+			t.HighCard = c.Rank()
+		}
+	}
+	return t
+}
+
+// rankMask returns a bit for each rank of the cards shifted by one so an
+// ace also sets the lowest bit for the wheel.
+func rankMask(cards []*Card) uint16 {
+	m := uint16(0)
+	for _, c := range cards {
+		m |= rankBits(c.Rank())
+	}
+	return m
+}
+
+func rankBits(r Rank) uint16 {
+	i := r.indexOf()
+	if i == -1 {
+		return 0
+	}
+	m := uint16(1) << uint(i+1)
+	if r == Ace {
+		m |= 1
+	}
+	return m
+}
+
+// straightWindows are the rank masks of the ten straights from the wheel
+// to ace high.
+var straightWindows = func() (w [10]uint16) {
+	for i := range w {
+		w[i] = 0x1F << uint(i)
+	}
+	return
+}()
+
+// makesStraight returns true if the ranks form a straight that the board
+// ranks don't form alone.
+func makesStraight(ranks, boardRanks uint16) bool {
+	for _, w := range straightWindows {
+		if ranks&w == w && boardRanks&w != w {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBackdoorStraight returns true if two more ranks make a straight that
+// uses a hole card.
+func hasBackdoorStraight(ranks, boardRanks uint16) bool {
+	ranks13 := allRanks()
+	for i, r1 := range ranks13 {
+		for _, r2 := range ranks13[i+1:] {
+			add := rankBits(r1) | rankBits(r2)
+			if makesStraight(ranks|add, boardRanks|add) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func countSuit(cards []*Card, s Suit) int {
+	n := 0
+	for _, c := range cards {
+		if c.Suit() == s {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,117 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+var drawTests = []struct {
+	holeCards []*Card
+	board     []*Card
+	ranking   Ranking
+	draws     Draws
+	outs      int
+}{
+	{
+		pokertest.Cards("Ah", "Kh"),
+		pokertest.Cards("7h", "2h", "9c"),
+		HighCard, FlushDraw | Overcards, 9,
+	},
+	{
+		pokertest.Cards("9s", "8d"),
+		pokertest.Cards("7h", "6c", "2s"),
+		HighCard, OpenEndedStraightDraw | Overcards, 8,
+	},
+	{
+		pokertest.Cards("9s", "7d"),
+		pokertest.Cards("6h", "5c", "Ks"),
+		HighCard, Gutshot, 4,
+	},
+	{
+		pokertest.Cards("Ad", "2c"),
+		pokertest.Cards("3h", "4s", "Kh"),
+		HighCard, Gutshot, 4,
+	},
+	{
+		pokertest.Cards("Jh", "Th"),
+		pokertest.Cards("9h", "8h", "2c"),
+		HighCard, FlushDraw | OpenEndedStraightDraw | Overcards | ComboDraw, 15,
+	},
+	{
+		pokertest.Cards("Jh", "Td"),
+		pokertest.Cards("9h", "4h", "2c"),
+		HighCard, BackdoorFlushDraw | BackdoorStraightDraw | Overcards, 0,
+	},
+	{
+		pokertest.Cards("Jh", "Th"),
+		pokertest.Cards("9h", "8h", "2c", "3h"),
+		Flush, 0, 0,
+	},
+	{
+		pokertest.Cards("Kd", "Kc"),
+		pokertest.Cards("7h", "2h", "9c"),
+		Pair, 0, 0,
+	},
+	{
+		pokertest.Cards("Ah", "Kh"),
+		pokertest.Cards("7h", "2h", "9c", "Qd", "3s"),
+		HighCard, 0, 0,
+	},
+}
+
+func TestAnalyzeDraws(t *testing.T) {
+	t.Parallel()
+	for _, test := range drawTests {
+		a := AnalyzeDraws(test.holeCards, test.board)
+		if a.Hand.Ranking() != test.ranking {
+			t.Errorf("AnalyzeDraws(%v, %v) ranking = %v; want %v", test.holeCards, test.board, a.Hand.Ranking(), test.ranking)
+		}
+		if a.Draws != test.draws {
+			t.Errorf("AnalyzeDraws(%v, %v) draws = %q; want %q", test.holeCards, test.board, a.Draws, test.draws)
+		}
+		if a.Outs.Count() != test.outs {
+			t.Errorf("AnalyzeDraws(%v, %v) outs = %v; want %d outs", test.holeCards, test.board, a.Outs, test.outs)
+		}
+	}
+}
+
+func TestDrawsString(t *testing.T) {
+	t.Parallel()
+	d := FlushDraw | Gutshot | ComboDraw
+	if s := d.String(); s != "flush draw, gutshot, combo draw" {
+		t.Fatalf("String() = %q", s)
+	}
+}
+
+var boardTests = []struct {
+	board   []*Card
+	texture BoardTexture
+}{
+	{
+		pokertest.Cards("Kh", "7h", "2h"),
+		BoardTexture{Monotone: true, FlushPossible: true, HighCard: King},
+	},
+	{
+		pokertest.Cards("9s", "8s", "7d"),
+		BoardTexture{TwoTone: true, Connected: true, HighCard: Nine},
+	},
+	{
+		pokertest.Cards("Ks", "Kd", "2c"),
+		BoardTexture{Paired: true, Rainbow: true, HighCard: King},
+	},
+	{
+		pokertest.Cards("As", "4d", "2c", "Jh"),
+		BoardTexture{Rainbow: true, Connected: true, HighCard: Ace},
+	},
+}
+
+func TestAnalyzeBoard(t *testing.T) {
+	t.Parallel()
+	for _, test := range boardTests {
+		if texture := AnalyzeBoard(test.board); *texture != test.texture {
+			t.Errorf("AnalyzeBoard(%v) = %+v; want %+v", test.board, *texture, test.texture)
+		}
+	}
+}
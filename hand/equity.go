@@ -0,0 +1,639 @@
+package hand
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrTooFewPlayers errors occur when an equity calculation is
+	// requested for less than two players.
+	ErrTooFewPlayers = errors.New("hand: equity requires at least two players")
+
+	// ErrInvalidBoard errors occur when a board has more than five cards.
+	ErrInvalidBoard = errors.New("hand: board can't have more than five cards")
+)
+
+// EquityConfig represents the configuration options for equity
+// calculations.
+type EquityConfig struct {
+	omaha           bool
+	hiLo            bool
+	trials          int
+	seed            int64
+	seeded          bool
+	workers         int
+	maxEnumerations int
+	qualifier       *Hand
+	anyLow          bool
+}
+
+// EquityOmaha configures CalcEquity to form hands from exactly two
+// hole cards and three board cards.
+func EquityOmaha(c *EquityConfig) {
+	c.omaha = true
+}
+
+// EquityHiLo configures CalcEquity to split each pot between the high
+// hand and the best ace-to-five low if one qualifies.  The qualifier is
+// eight or better unless EquityQualifier configures another.
+func EquityHiLo(c *EquityConfig) {
+	c.hiLo = true
+}
+
+// EquityQualifier configures the worst ace-to-five low hand that wins the
+// low half of the pot with EquityHiLo.  A nil hand lets any low win.
+func EquityQualifier(worst *Hand) func(*EquityConfig) {
+	return func(c *EquityConfig) {
+		c.qualifier = worst
+		c.anyLow = worst == nil
+	}
+}
+
+// EquityTrials configures the number of boards CalcEquity samples when
+// it can't enumerate every board.  The default is 100,000.
+func EquityTrials(n int) func(*EquityConfig) {
+	return func(c *EquityConfig) {
+		c.trials = n
+	}
+}
+
+// EquitySeed configures the seed of CalcEquity's sampling so results can
+// be reproduced.  Sampling is only reproducible with the same number of
+// workers.
+func EquitySeed(seed int64) func(*EquityConfig) {
+	return func(c *EquityConfig) {
+		c.seed = seed
+		c.seeded = true
+	}
+}
+
+// EquityWorkers configures the number of goroutines CalcEquity uses.
+// The default is the number of CPUs.
+func EquityWorkers(n int) func(*EquityConfig) {
+	return func(c *EquityConfig) {
+		c.workers = n
+	}
+}
+
+// EquityMaxEnumerations configures the number of boards above which
+// CalcEquity samples instead of enumerating.  The default is 100,000.
+func EquityMaxEnumerations(n int) func(*EquityConfig) {
+	return func(c *EquityConfig) {
+		c.maxEnumerations = n
+	}
+}
+
+// Equity is a player's share of the boards in an equity calculation.
+// Win, Tie, Lose and Equity are percentages.
+type Equity struct {
+	// Win is the percentage of boards on which the player scoops the pot.
+	Win float64 `json:"win"`
+
+	// Tie is the percentage of boards on which the player wins part of
+	// the pot.
+	Tie float64 `json:"tie"`
+
+	// Lose is the percentage of boards on which the player wins nothing.
+	Lose float64 `json:"lose"`
+
+	// Equity is the player's expected percentage of the pot.
+	Equity float64 `json:"equity"`
+}
+
+// String returns a string useful for debugging.
+func (e Equity) String() string {
+	const format = "{Win: %.2f%%, Tie: %.2f%%, Lose: %.2f%%, Equity: %.2f%%}"
+	return fmt.Sprintf(format, e.Win, e.Tie, e.Lose, e.Equity)
+}
+
+// EquityResult is the result of an equity calculation.
+type EquityResult struct {
+	// Players holds each player's equity in the order of the hole cards
+	// given to CalcEquity.
+	Players []Equity `json:"players"`
+
+	// Boards is the number of boards evaluated.
+	Boards int `json:"boards"`
+
+	// Exact indicates that every possible board was enumerated instead
+	// of sampled.
+	Exact bool `json:"exact"`
+}
+
+// CalcEquity returns each player's equity given their hole cards, a
+// partial board and cards known to be out of the deck.  Every remaining
+// board is enumerated if there are no more than the maximum enumerations,
+// otherwise boards are sampled across a pool of goroutines.  An error is
+// returned if there are less than two players, the board has more than
+// five cards or a card appears more than once.
+func CalcEquity(holeCards [][]*Card, board, dead []*Card, options ...func(*EquityConfig)) (*EquityResult, error) {
+	c := newEquityConfig(options)
+	if len(holeCards) < 2 {
+		return nil, ErrTooFewPlayers
+	}
+	if len(board) > 5 {
+		return nil, ErrInvalidBoard
+	}
+	for i, cards := range holeCards {
+		if len(cards) < c.minHoleCards() {
+			return nil, fmt.Errorf("hand: player %d has %d hole cards but requires at least %d", i, len(cards), c.minHoleCards())
+		}
+	}
+
+	known := [][]*Card{board, dead}
+	known = append(known, holeCards...)
+	if _, err := remainingCards(known...); err != nil {
+		return nil, err
+	}
+
+	players := [][]equityCombo{}
+	for _, cards := range holeCards {
+		players = append(players, []equityCombo{newEquityCombo(cards, 1)})
+	}
+	return newEquityCalc(c, players, board, dead).calc()
+}
+
+// RangeEquity returns the equity of each range given a partial board and
+// cards known to be out of the deck.  Combos that conflict with the
+// board or dead cards are removed and the remaining combos are weighted
+// by their range weights.  Range versus hand equity is calculated by
+// giving the hand a range of its own with NewRange.  An error is
+// returned if there are less than two ranges, the board has more than
+// five cards, a card appears more than once on the board and dead cards
+// or a range has no combos left.
+func RangeEquity(ranges []*Range, board, dead []*Card, options ...func(*EquityConfig)) (*EquityResult, error) {
+	c := newEquityConfig(options)
+	if len(ranges) < 2 {
+		return nil, ErrTooFewPlayers
+	}
+	if len(board) > 5 {
+		return nil, ErrInvalidBoard
+	}
+	if _, err := remainingCards(board, dead); err != nil {
+		return nil, err
+	}
+
+	players := [][]equityCombo{}
+	known := append(append([]*Card{}, board...), dead...)
+	for i, r := range ranges {
+		combos := []equityCombo{}
+		for _, combo := range r.Without(known...).Combos() {
+			if len(combo.Cards) < c.minHoleCards() {
+				return nil, fmt.Errorf("hand: range %d has a combo of %d hole cards but requires at least %d", i, len(combo.Cards), c.minHoleCards())
+			}
+			if _, err := remainingCards(combo.Cards); err != nil {
+				return nil, err
+			}
+			combos = append(combos, newEquityCombo(combo.Cards, combo.Weight))
+		}
+		if len(combos) == 0 {
+			return nil, fmt.Errorf("hand: range %d has no combos that don't conflict with the board or dead cards", i)
+		}
+		players = append(players, combos)
+	}
+	return newEquityCalc(c, players, board, dead).calc()
+}
+
+func newEquityConfig(options []func(*EquityConfig)) *EquityConfig {
+	c := &EquityConfig{
+		trials:          100000,
+		workers:         runtime.NumCPU(),
+		maxEnumerations: 100000,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	if !c.seeded {
+		c.seed = time.Now().UnixNano()
+	}
+	if c.workers < 1 {
+		c.workers = 1
+	}
+	return c
+}
+
+func (c *EquityConfig) minHoleCards() int {
+	if c.omaha {
+		return 4
+	}
+	return 1
+}
+
+// ErrNoCompatibleCombos errors occur when the ranges of an equity
+// calculation can't be dealt without a card appearing twice.
+var ErrNoCompatibleCombos = errors.New("hand: ranges have no combination of combos without a shared card")
+
+// maxSampleAttempts is the number of times a sampled deal is retried
+// because of combos sharing a card before giving up.
+const maxSampleAttempts = 10000
+
+// equityCombo is a player's possible hole cards in an equity calculation.
+type equityCombo struct {
+	codes  []cardCode
+	mask   CardSet
+	weight float64
+}
+
+func newEquityCombo(cards []*Card, weight float64) equityCombo {
+	codes := encodeCards(cards)
+	mask := CardSet(0)
+	for _, c := range codes {
+		mask |= c.bit()
+	}
+	return equityCombo{codes: codes, mask: mask, weight: weight}
+}
+
+// equityCalc holds the state of a single equity calculation.
+type equityCalc struct {
+	config    *EquityConfig
+	high      *evalTable
+	low       *evalTable
+	qualifier int
+	players   [][]equityCombo
+	weights   [][]float64
+	board     []cardCode
+	deck      []cardCode
+	needed    int
+
+	sync.Mutex
+	boards int
+	failed bool
+	totals *equityTally
+}
+
+func newEquityCalc(c *EquityConfig, players [][]equityCombo, board, dead []*Card) *equityCalc {
+	deck, _ := remainingCards(board, dead)
+	e := &equityCalc{
+		config:  c,
+		high:    Config{}.evalTable(),
+		players: players,
+		board:   encodeCards(board),
+		deck:    encodeCards(deck),
+		needed:  5 - len(board),
+		totals:  newEquityTally(len(players)),
+	}
+	for _, combos := range players {
+		cumulative := []float64{}
+		total := 0.0
+		for _, combo := range combos {
+			total += combo.weight
+			cumulative = append(cumulative, total)
+		}
+		e.weights = append(e.weights, cumulative)
+	}
+	if c.hiLo {
+		lowConfig := newConfig([]func(*Config){AceToFiveLow})
+		e.low = lowConfig.evalTable()
+		switch {
+		case c.anyLow:
+			e.qualifier = math.MaxInt32
+		case c.qualifier != nil:
+			e.qualifier = c.qualifier.Strength()
+		default:
+			e.qualifier = Evaluate([]*Card{EightSpades, SevenSpades, SixSpades, FiveSpades, FourSpades}, AceToFiveLow)
+		}
+	}
+	return e
+}
+
+// calc enumerates or samples the boards and returns the result.
+func (e *equityCalc) calc() (*EquityResult, error) {
+	deals := 1.0
+	holeCards := 0
+	for _, combos := range e.players {
+		deals *= float64(len(combos))
+		holeCards += len(combos[0].codes)
+	}
+	deals *= float64(binomial(len(e.deck)-holeCards, e.needed))
+
+	exact := deals <= float64(e.config.maxEnumerations)
+	if exact {
+		e.run(e.enumerate)
+	} else {
+		e.run(e.sample)
+	}
+	if e.failed || e.boards == 0 {
+		return nil, ErrNoCompatibleCombos
+	}
+
+	result := &EquityResult{Boards: e.boards, Exact: exact}
+	t := e.totals
+	for i := range e.players {
+		result.Players = append(result.Players, Equity{
+			Win:    100 * t.wins[i] / t.weight,
+			Tie:    100 * t.ties[i] / t.weight,
+			Lose:   100 * t.losses[i] / t.weight,
+			Equity: 100 * t.equity[i] / t.weight,
+		})
+	}
+	return result, nil
+}
+
+// run splits the work of f across the workers and merges each worker's
+// tally.
+func (e *equityCalc) run(f func(worker int, t *equityTally)) {
+	wg := sync.WaitGroup{}
+	for w := 0; w < e.config.workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			t := newEquityTally(len(e.players))
+			f(w, t)
+			e.merge(t)
+		}(w)
+	}
+	wg.Wait()
+}
+
+// enumerate evaluates every deal whose position in the enumeration
+// belongs to the worker.
+func (e *equityCalc) enumerate(worker int, t *equityTally) {
+	workers := e.config.workers
+	holeCards := make([][]cardCode, len(e.players))
+	board := make([]cardCode, 5)
+	copy(board, e.board)
+	deck := make([]cardCode, 0, len(e.deck))
+	count := 0
+
+	var deal func(player int, used CardSet, weight float64)
+	deal = func(player int, used CardSet, weight float64) {
+		if player < len(e.players) {
+			for _, combo := range e.players[player] {
+				if combo.mask&used == 0 {
+					holeCards[player] = combo.codes
+					deal(player+1, used|combo.mask, weight*combo.weight)
+				}
+			}
+			return
+		}
+
+		deck = deck[:0]
+		for _, c := range e.deck {
+			if c.bit()&used == 0 {
+				deck = append(deck, c)
+			}
+		}
+		forEachCombo(len(deck), e.needed, func(indices []int) {
+			if count%workers == worker {
+				for i, index := range indices {
+					board[len(e.board)+i] = deck[index]
+				}
+				e.evaluate(holeCards, board, weight, t)
+			}
+			count++
+		})
+	}
+	deal(0, 0, 1)
+}
+
+// sample evaluates the worker's share of randomly dealt boards.  Combos
+// are drawn by weight and redrawn if they share a card.
+func (e *equityCalc) sample(worker int, t *equityTally) {
+	workers := e.config.workers
+	trials := e.config.trials / workers
+	if worker < e.config.trials%workers {
+		trials++
+	}
+
+	r := rand.New(rand.NewSource(e.config.seed + int64(worker)))
+	holeCards := make([][]cardCode, len(e.players))
+	deck := make([]cardCode, len(e.deck))
+	copy(deck, e.deck)
+	board := make([]cardCode, 5)
+	copy(board, e.board)
+	for i := 0; i < trials; i++ {
+		used := CardSet(0)
+		for attempt := 0; ; attempt++ {
+			if attempt == maxSampleAttempts {
+				t.failed = true
+				return
+			}
+			used = 0
+			for p, combos := range e.players {
+				combo := combos[weightedIndex(r, e.weights[p])]
+				if combo.mask&used != 0 {
+					used = ^CardSet(0)
+					break
+				}
+				holeCards[p] = combo.codes
+				used |= combo.mask
+			}
+			if used != ^CardSet(0) {
+				break
+			}
+		}
+
+		for j := 0; j < e.needed; {
+			k := j + r.Intn(len(deck)-j)
+			deck[j], deck[k] = deck[k], deck[j]
+			if deck[j].bit()&used == 0 {
+				board[len(e.board)+j] = deck[j]
+				j++
+			}
+		}
+		e.evaluate(holeCards, board, 1, t)
+	}
+}
+
+// weightedIndex returns a random index of the cumulative weights.
+func weightedIndex(r *rand.Rand, cumulative []float64) int {
+	if len(cumulative) == 1 {
+		return 0
+	}
+	x := r.Float64() * cumulative[len(cumulative)-1]
+	return sort.SearchFloat64s(cumulative, x)
+}
+
+// evaluate awards the pot for a complete board.
+func (e *equityCalc) evaluate(holeCards [][]cardCode, board []cardCode, weight float64, t *equityTally) {
+	high := t.high
+	for i, hole := range holeCards {
+		high[i] = e.strength(e.high, hole, board, false)
+	}
+
+	lowExists := false
+	low := t.low
+	if e.low != nil {
+		for i, hole := range holeCards {
+			low[i] = e.strength(e.low, hole, board, true)
+			lowExists = lowExists || low[i] <= e.qualifier
+		}
+	}
+
+	shares := t.shares
+	for i := range shares {
+		shares[i] = 0
+	}
+	if lowExists {
+		award(shares, high, 0.5, false, -1)
+		award(shares, low, 0.5, true, e.qualifier)
+	} else {
+		award(shares, high, 1, false, -1)
+	}
+	t.add(shares, weight)
+}
+
+func (e *equityCalc) strength(table *evalTable, hole, board []cardCode, low bool) int {
+	if e.config.omaha {
+		_, _, s := table.bestExactly(hole, board, 2, low)
+		return s
+	}
+	var buf [16]cardCode
+	codes := append(append(buf[:0], hole...), board...)
+	_, s := table.best(codes, low)
+	return s
+}
+
+// award divides the amount between the players with the best strength.
+// Low strengths above the qualifier aren't eligible.
+func award(shares []float64, strengths []int, amount float64, low bool, qualifier int) {
+	best := -1
+	winners := 0
+	for _, s := range strengths {
+		if low && s > qualifier {
+			continue
+		}
+		switch {
+		case best == -1 || (low && s < best) || (!low && s > best):
+			best = s
+			winners = 1
+		case s == best:
+			winners++
+		}
+	}
+	for i, s := range strengths {
+		if s == best {
+			shares[i] += amount / float64(winners)
+		}
+	}
+}
+
+func (e *equityCalc) merge(t *equityTally) {
+	e.Lock()
+	defer e.Unlock()
+	e.boards += t.boards
+	e.failed = e.failed || t.failed
+	e.totals.weight += t.weight
+	for i := range e.players {
+		e.totals.wins[i] += t.wins[i]
+		e.totals.ties[i] += t.ties[i]
+		e.totals.losses[i] += t.losses[i]
+		e.totals.equity[i] += t.equity[i]
+	}
+}
+
+// equityTally is a single worker's weighted counts.
+type equityTally struct {
+	boards int
+	failed bool
+	weight float64
+	wins   []float64
+	ties   []float64
+	losses []float64
+	equity []float64
+
+	// scratch space for evaluate
+	high   []int
+	low    []int
+	shares []float64
+}
+
+func newEquityTally(players int) *equityTally {
+	return &equityTally{
+		wins:   make([]float64, players),
+		ties:   make([]float64, players),
+		losses: make([]float64, players),
+		equity: make([]float64, players),
+		high:   make([]int, players),
+		low:    make([]int, players),
+		shares: make([]float64, players),
+	}
+}
+
+func (t *equityTally) add(shares []float64, weight float64) {
+	t.boards++
+	t.weight += weight
+	for i, share := range shares {
+		switch {
+		case share == 1:
+			t.wins[i] += weight
+		case share > 0:
+			t.ties[i] += weight
+		default:
+			t.losses[i] += weight
+		}
+		t.equity[i] += share * weight
+	}
+}
+
+// forEachCombo calls f with every combination of k indexes out of n in
+// lexicographic order.  f must not keep the slice.
+func forEachCombo(n, k int, f func(indices []int)) {
+	if k > n {
+		return
+	}
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	for {
+		f(indices)
+		i := k - 1
+		for i >= 0 && indices[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// remainingCards returns the cards of the deck that aren't in any of the
+// given lists.  An error is returned if a card is invalid or appears
+// more than once.
+func remainingCards(lists ...[]*Card) ([]*Card, error) {
+	used := CardSet(0)
+	for _, cards := range lists {
+		for _, c := range cards {
+			if c == nil || c.Index() == -1 {
+				return nil, fmt.Errorf("hand: invalid card %v", c)
+			}
+			if used.Contains(c) {
+				return nil, fmt.Errorf("hand: card %v appears more than once", c)
+			}
+			used = used.Add(c)
+		}
+	}
+	return NewCardSet(Cards()...).Difference(used).Cards(), nil
+}
+
+func encodeCards(cards []*Card) []cardCode {
+	codes := make([]cardCode, len(cards))
+	for i, c := range cards {
+		codes[i] = encodeCard(c)
+	}
+	return codes
+}
+
+// binomial returns n choose k.
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	b := 1
+	for i := 1; i <= k; i++ {
+		b = b * (n - k + i) / i
+	}
+	return b
+}
@@ -0,0 +1,198 @@
+package hand_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+type equityTest struct {
+	holeCards [][]*Card
+	board     []*Card
+	dead      []*Card
+	options   []func(*EquityConfig)
+	exact     bool
+	equity    []float64
+}
+
+var equityTests = []equityTest{
+	// river is decided
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("As", "Ah"),
+			pokertest.Cards("Ks", "Kh"),
+		},
+		board:  pokertest.Cards("2c", "7d", "9h", "Jc", "3s"),
+		exact:  true,
+		equity: []float64{100, 0},
+	},
+	// chopped on the board
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("2s", "3h"),
+			pokertest.Cards("2d", "3c"),
+		},
+		board:  pokertest.Cards("Ac", "Kd", "Qh", "Jc", "Ts"),
+		exact:  true,
+		equity: []float64{50, 50},
+	},
+	// set over set on the turn with one out
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("Ks", "Kh"),
+			pokertest.Cards("Qs", "Qh"),
+		},
+		board:  pokertest.Cards("Kd", "Qd", "2c", "7s"),
+		exact:  true,
+		equity: []float64{100 * 43.0 / 44.0, 100 * 1.0 / 44.0},
+	},
+	// the case queen is dead
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("Ks", "Kh"),
+			pokertest.Cards("Qs", "Qh"),
+		},
+		board:  pokertest.Cards("Kd", "Qd", "2c", "7s"),
+		dead:   pokertest.Cards("Qc"),
+		exact:  true,
+		equity: []float64{100, 0},
+	},
+	// omaha uses exactly two hole cards so the board flush doesn't count
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("As", "Kd", "Qc", "2h"),
+			pokertest.Cards("7c", "7d", "8c", "9d"),
+		},
+		board:   pokertest.Cards("3s", "4s", "5s", "6s", "Jh"),
+		options: []func(*EquityConfig){EquityOmaha},
+		exact:   true,
+		equity:  []float64{0, 100},
+	},
+	// omaha hi lo splits the pot
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("As", "2d", "Kc", "Kh"),
+			pokertest.Cards("6s", "7d", "Jc", "Jh"),
+		},
+		board:   pokertest.Cards("3c", "4h", "5d", "Qh", "Td"),
+		options: []func(*EquityConfig){EquityOmaha, EquityHiLo},
+		exact:   true,
+		equity:  []float64{50, 50},
+	},
+	// a nine low doesn't qualify for eight or better
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("9s", "2d", "Tc", "Th"),
+			pokertest.Cards("Qs", "Qd", "Jc", "Jh"),
+		},
+		board:   pokertest.Cards("3c", "4h", "5d", "Qh", "Kd"),
+		options: []func(*EquityConfig){EquityOmaha, EquityHiLo},
+		exact:   true,
+		equity:  []float64{0, 100},
+	},
+	// but does for nine or better
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("9s", "2d", "Tc", "Th"),
+			pokertest.Cards("Qs", "Qd", "Jc", "Jh"),
+		},
+		board: pokertest.Cards("3c", "4h", "5d", "Qh", "Kd"),
+		options: []func(*EquityConfig){EquityOmaha, EquityHiLo, EquityQualifier(
+			New(pokertest.Cards("9s", "8s", "7s", "6s", "5s"), AceToFiveLow),
+		)},
+		exact:  true,
+		equity: []float64{50, 50},
+	},
+	// and any low wins without a qualifier
+	{
+		holeCards: [][]*Card{
+			pokertest.Cards("9s", "2d", "Tc", "Th"),
+			pokertest.Cards("Qs", "Qd", "Jc", "Jh"),
+		},
+		board:   pokertest.Cards("3c", "4h", "5d", "Qh", "Kd"),
+		options: []func(*EquityConfig){EquityOmaha, EquityHiLo, EquityQualifier(nil)},
+		exact:   true,
+		equity:  []float64{50, 50},
+	},
+}
+
+func TestCalcEquity(t *testing.T) {
+	for _, test := range equityTests {
+		r, err := CalcEquity(test.holeCards, test.board, test.dead, test.options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Exact != test.exact {
+			t.Fatalf("CalcEquity(%v, %v) exact = %v; want %v", test.holeCards, test.board, r.Exact, test.exact)
+		}
+		for i, e := range r.Players {
+			if math.Abs(e.Equity-test.equity[i]) > 0.001 {
+				t.Fatalf("CalcEquity(%v, %v) player %d = %v; want %.2f%% equity", test.holeCards, test.board, i, e, test.equity[i])
+			}
+			if math.Abs(e.Win+e.Tie+e.Lose-100) > 0.001 {
+				t.Fatalf("CalcEquity(%v, %v) player %d = %v; want win, tie and lose to total 100%%", test.holeCards, test.board, i, e)
+			}
+		}
+	}
+}
+
+func TestCalcEquitySampling(t *testing.T) {
+	holeCards := [][]*Card{
+		pokertest.Cards("As", "Ah"),
+		pokertest.Cards("Ks", "Kh"),
+	}
+	options := []func(*EquityConfig){EquitySeed(1), EquityWorkers(4), EquityTrials(40000)}
+	r, err := CalcEquity(holeCards, nil, nil, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Exact || r.Boards != 40000 {
+		t.Fatalf("CalcEquity() = %d boards, exact %v; want 40000 sampled boards", r.Boards, r.Exact)
+	}
+	// aces are about 82% against kings
+	if e := r.Players[0].Equity; e < 80 || e > 84 {
+		t.Fatalf("CalcEquity() aces = %v; want about 82%% equity", r.Players[0])
+	}
+
+	again, err := CalcEquity(holeCards, nil, nil, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Players[0] != r.Players[0] {
+		t.Fatalf("CalcEquity() with the same seed = %v; want %v", again.Players[0], r.Players[0])
+	}
+}
+
+func TestCalcEquityErrors(t *testing.T) {
+	if _, err := CalcEquity([][]*Card{pokertest.Cards("As", "Ah")}, nil, nil); err != ErrTooFewPlayers {
+		t.Fatalf("CalcEquity() with one player err = %v; want %v", err, ErrTooFewPlayers)
+	}
+
+	holeCards := [][]*Card{
+		pokertest.Cards("As", "Ah"),
+		pokertest.Cards("Ks", "Kh"),
+	}
+	if _, err := CalcEquity(holeCards, pokertest.Cards("2c", "3c", "4c", "5c", "6c", "7c"), nil); err != ErrInvalidBoard {
+		t.Fatalf("CalcEquity() with six board cards err = %v; want %v", err, ErrInvalidBoard)
+	}
+	if _, err := CalcEquity(holeCards, pokertest.Cards("As", "3c", "4c"), nil); err == nil {
+		t.Fatal("CalcEquity() with a duplicate card should return an error")
+	}
+	if _, err := CalcEquity(holeCards, nil, nil, EquityOmaha); err == nil {
+		t.Fatal("CalcEquity() with two omaha hole cards should return an error")
+	}
+}
+
+func BenchmarkCalcEquityFlop(b *testing.B) {
+	holeCards := [][]*Card{
+		pokertest.Cards("As", "Kd"),
+		pokertest.Cards("Qs", "Qh"),
+		pokertest.Cards("8c", "9c"),
+	}
+	board := pokertest.Cards("Ks", "7c", "2c")
+	for i := 0; i < b.N; i++ {
+		CalcEquity(holeCards, board, nil)
+	}
+}
@@ -0,0 +1,267 @@
+package hand
+
+import (
+	"math/bits"
+	"sync"
+
+	"github.com/rolends1986/poker/util"
+)
+
+// Evaluate returns the strength of the hand New would form from the
+// given cards and configuration options.  A higher strength is always
+// the higher hand, so low games select the lowest strength.  Strengths
+// are only comparable between cards evaluated with the same options.
+// Five, six and seven cards are evaluated with precomputed lookup
+// tables and never allocate a Hand.
+func Evaluate(cards []*Card, options ...func(*Config)) int {
+	c := newConfig(options)
+	if c.badugi {
+		return badugiHand(cards, c).strength
+	}
+	if c.hasWilds(cards) {
+		return wildHand(cards, c).strength
+	}
+	if len(cards) < 5 {
+		return handForFiveCards(copyCards(cards), c).strength
+	}
+	_, strength := bestCombo(cards, c)
+	return strength
+}
+
+// Strength returns the integer strength of the hand.  See Evaluate.
+func (h *Hand) Strength() int {
+	return h.strength
+}
+
+// A cardCode is the Cactus Kev encoding of a card used to index the
+// lookup tables:
+//
+//	xxxbbbbb bbbbbbbb cdhsrrrr xxpppppp
+//
+// b is a bit for the rank, cdhs is a bit for the suit, r is the rank
+// index and p is the prime of the rank.
+type cardCode uint32
+
+func encodeCard(c *Card) cardCode {
+	return newCardCode(c.Rank().indexOf(), suitBit(c.Suit()))
+}
+
+// newCardCode returns the code of the rank index and suit bit.
+func newCardCode(r int, suit cardCode) cardCode {
+	return cardCode(primes[r]) | cardCode(r)<<8 | suit<<12 | 1<<uint(16+r)
+}
+
+// bit returns the card set of the card.  It matches Card.Index.
+func (c cardCode) bit() CardSet {
+	rank := uint(c>>8) & 0xF
+	suit := uint(bits.TrailingZeros32(uint32(c>>12) & 0xF))
+	return 1 << (rank*4 + suit)
+}
+
+func suitBit(s Suit) cardCode {
+	switch s {
+	case Spades:
+		return 1
+	case Hearts:
+		return 2
+	case Diamonds:
+		return 4
+	}
+	return 8
+}
+
+// primes are the rank primes from two through ace.
+var primes = [13]uint32{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+
+// evalTable holds the strengths of every distinct five card hand for a
+// single hand configuration.
+type evalTable struct {
+	// flushes is indexed by the rank bits of five suited cards.
+	flushes [1 << 13]int32
+
+	// unique5 is indexed by the rank bits of five unsuited cards with
+	// no paired ranks.
+	unique5 [1 << 13]int32
+
+	// products is keyed by the product of the rank primes of hands with
+	// at least one paired rank.
+	products map[uint32]int32
+}
+
+func (t *evalTable) eval(c1, c2, c3, c4, c5 cardCode) int {
+	q := (c1 | c2 | c3 | c4 | c5) >> 16
+	if c1&c2&c3&c4&c5&0xF000 != 0 {
+		return int(t.flushes[q])
+	}
+	if s := t.unique5[q]; s != 0 {
+		return int(s)
+	}
+	p := uint32(c1&0xFF) * uint32(c2&0xFF) * uint32(c3&0xFF) * uint32(c4&0xFF) * uint32(c5&0xFF)
+	return int(t.products[p])
+}
+
+// newEvalTable builds the table for the configuration by ranking one
+// hand of every distinct five card pattern.
+func newEvalTable(c Config) *evalTable {
+	ranks := allRanks()
+	t := &evalTable{products: map[uint32]int32{}}
+
+	for _, combo := range util.Combinations(len(ranks), 5) {
+		q := 0
+		suited := []*Card{}
+		unsuited := []*Card{}
+		for i, r := range combo {
+			q |= 1 << uint(r)
+			suit := Spades
+			if i == 0 {
+				suit = Hearts
+			}
+			suited = append(suited, &Card{TheRank: ranks[r], TheSuit: Spades})
+			unsuited = append(unsuited, &Card{TheRank: ranks[r], TheSuit: suit})
+		}
+		t.flushes[q] = int32(handForFiveCards(suited, c).strength)
+		t.unique5[q] = int32(handForFiveCards(unsuited, c).strength)
+	}
+
+	counts := make([]int, len(ranks))
+	var fill func(rank, left int)
+	fill = func(rank, left int) {
+		if left == 0 {
+			paired := false
+			product := uint32(1)
+			cards := []*Card{}
+			for r, n := range counts {
+				paired = paired || n > 1
+				for i := 0; i < n; i++ {
+					product *= primes[r]
+					cards = append(cards, &Card{TheRank: ranks[r], TheSuit: allSuits()[i]})
+				}
+			}
+			if paired {
+				t.products[product] = int32(handForFiveCards(cards, c).strength)
+			}
+			return
+		}
+		if rank == len(ranks) {
+			return
+		}
+		for n := 0; n <= 4 && n <= left; n++ {
+			counts[rank] = n
+			fill(rank+1, left-n)
+		}
+		counts[rank] = 0
+	}
+	fill(0, 5)
+	return t
+}
+
+// evalTables caches a lookup table for each combination of the
+// configuration options that change hand strength.
+var evalTables [32]struct {
+	once  sync.Once
+	table *evalTable
+}
+
+func (c Config) evalTable() *evalTable {
+	key := 0
+	if c.aceIsLow {
+		key |= 1
+	}
+	if c.ignoreStraights {
+		key |= 2
+	}
+	if c.ignoreFlushes {
+		key |= 4
+	}
+	if c.noWheel {
+		key |= 8
+	}
+	if c.shortDeck {
+		key |= 16
+	}
+	e := &evalTables[key]
+	e.once.Do(func() {
+		e.table = newEvalTable(c)
+	})
+	return e.table
+}
+
+// bestCombo returns the five cards New would select and their strength.
+// cards must contain at least five cards.
+func bestCombo(cards []*Card, c Config) ([]*Card, int) {
+	var buf [7]cardCode
+	codes := buf[:0]
+	for _, card := range cards {
+		codes = append(codes, encodeCard(card))
+	}
+
+	combo, strength := c.evalTable().best(codes, c.sorting == SortingLow)
+	selected := make([]*Card, 5)
+	for i, index := range combo {
+		selected[i] = cards[index]
+	}
+	return selected, strength
+}
+
+// best returns the five card combination of codes with the highest
+// strength, or the lowest if low is true, along with its strength.
+func (t *evalTable) best(codes []cardCode, low bool) ([]int, int) {
+	var best []int
+	bestStrength := 0
+	for _, combo := range indexCombos(len(codes), 5) {
+		s := t.eval(codes[combo[0]], codes[combo[1]], codes[combo[2]], codes[combo[3]], codes[combo[4]])
+		if best == nil || (low && s < bestStrength) || (!low && s > bestStrength) {
+			best, bestStrength = combo, s
+		}
+	}
+	return best, bestStrength
+}
+
+// bestExactly returns the hole and board index combinations and the
+// strength of the best hand formed from exactly use hole cards and five
+// minus use board cards.
+func (t *evalTable) bestExactly(hole, board []cardCode, use int, low bool) ([]int, []int, int) {
+	var bestHole, bestBoard []int
+	bestStrength := -1
+	var five [5]cardCode
+	for _, h := range indexCombos(len(hole), use) {
+		for i, index := range h {
+			five[i] = hole[index]
+		}
+		for _, b := range indexCombos(len(board), 5-use) {
+			for i, index := range b {
+				five[use+i] = board[index]
+			}
+			s := t.eval(five[0], five[1], five[2], five[3], five[4])
+			if bestStrength == -1 || (low && s < bestStrength) || (!low && s > bestStrength) {
+				bestHole, bestBoard, bestStrength = h, b, s
+			}
+		}
+	}
+	return bestHole, bestBoard, bestStrength
+}
+
+// smallCombos holds the index combinations of up to twelve cards so
+// the evaluators don't allocate them on every call.
+var smallCombos = func() (c [13][6][][]int) {
+	for n := range c {
+		for k := range c[n] {
+			c[n][k] = util.Combinations(n, k)
+		}
+	}
+	return
+}()
+
+// indexCombos returns the result of util.Combinations.
+func indexCombos(n, k int) [][]int {
+	if n < len(smallCombos) && k < len(smallCombos[n]) {
+		return smallCombos[n][k]
+	}
+	return util.Combinations(n, k)
+}
+
+func copyCards(cards []*Card) []*Card {
+	c := make([]*Card, len(cards))
+	copy(c, cards)
+	return c
+}
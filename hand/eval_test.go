@@ -0,0 +1,99 @@
+package hand
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/rolends1986/poker/util"
+)
+
+// bruteForce forms the hand by ranking every five card combination,
+// which is how New worked before the lookup tables.
+func bruteForce(cards []*Card, options ...func(*Config)) *Hand {
+	c := newConfig(options)
+	hands := []*Hand{}
+	for _, combo := range util.Combinations(len(cards), 5) {
+		selected := []*Card{}
+		for _, i := range combo {
+			selected = append(selected, cards[i])
+		}
+		hands = append(hands, handForFiveCards(selected, c))
+	}
+	return Sort(c.sorting, DESC, hands...)[0]
+}
+
+func randomCards(r *rand.Rand, n int) []*Card {
+	cards := Cards()
+	selected := []*Card{}
+	for _, i := range r.Perm(len(cards))[:n] {
+		selected = append(selected, cards[i])
+	}
+	return selected
+}
+
+func TestEvaluateMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	configs := [][]func(*Config){nil, {Low}, {AceToFiveLow}, {DeuceToSevenLow}, {ShortDeck}}
+	for i := 0; i < 3000; i++ {
+		cards := randomCards(r, 5+i%3)
+		for _, options := range configs {
+			expected := bruteForce(cards, options...)
+			actual := New(cards, options...)
+			if actual.CompareTo(expected) != 0 || actual.Ranking() != expected.Ranking() {
+				t.Fatalf("New(%v) = %v; want %v", cards, actual, expected)
+			}
+			if actual.Description() != expected.Description() {
+				t.Fatalf("New(%v) description = %q; want %q", cards, actual.Description(), expected.Description())
+			}
+			if s := Evaluate(cards, options...); s != expected.Strength() {
+				t.Fatalf("Evaluate(%v) = %d; want %d", cards, s, expected.Strength())
+			}
+		}
+	}
+}
+
+func TestAceToFiveLowAceIsLowest(t *testing.T) {
+	wheel := New([]*Card{SixSpades, FiveSpades, FourHearts, ThreeDiamonds, AceClubs}, AceToFiveLow)
+	deuce := New([]*Card{SixSpades, FiveSpades, FourHearts, ThreeDiamonds, TwoClubs}, AceToFiveLow)
+	if wheel.CompareTo(deuce) >= 0 {
+		t.Fatalf("expected %v to be lower than %v", wheel, deuce)
+	}
+}
+
+func BenchmarkEvaluate7(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	hands := [][]*Card{}
+	for i := 0; i < 1000; i++ {
+		hands = append(hands, randomCards(r, 7))
+	}
+	Evaluate(hands[0])
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Evaluate(hands[i%len(hands)])
+	}
+}
+
+func BenchmarkNew7(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	hands := [][]*Card{}
+	for i := 0; i < 1000; i++ {
+		hands = append(hands, randomCards(r, 7))
+	}
+	New(hands[0])
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		New(hands[i%len(hands)])
+	}
+}
+
+func BenchmarkBruteForce7(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	hands := [][]*Card{}
+	for i := 0; i < 1000; i++ {
+		hands = append(hands, randomCards(r, 7))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bruteForce(hands[i%len(hands)])
+	}
+}
@@ -0,0 +1,215 @@
+package hand
+
+import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sync"
+)
+
+var (
+	// ErrCommitmentMismatch is returned by VerifyDeck when the revealed
+	// server seed doesn't match the commitment.
+	ErrCommitmentMismatch = errors.New("hand: revealed server seed doesn't match the commitment")
+
+	// ErrInvalidServerSeed is returned by VerifyDeck when the revealed
+	// server seed isn't hex encoded.
+	ErrInvalidServerSeed = errors.New("hand: revealed server seed isn't hex encoded")
+)
+
+// A Reveal is the material needed to recompute a deck dealt by a
+// FairDealer.  Before the hand only the Commitment is published.
+type Reveal struct {
+	// Commitment is the hex encoded SHA-256 hash of the server seed and
+	// the deck it shuffles, published before the hand.
+	Commitment string `json:"commitment" bson:"commitment"`
+
+	// ServerSeed is the hex encoded secret seed of the dealer.
+	ServerSeed string `json:"serverSeed" bson:"serverSeed"`
+
+	// ClientSeeds are the seeds added by players in the order they were
+	// added.
+	ClientSeeds []string `json:"clientSeeds" bson:"clientSeeds"`
+}
+
+// A FairDealer is a provably fair Dealer.  Before each deck it commits to
+// a hash of a secret server seed and the deck that seed shuffles.  Client
+// seeds added before the deck is dealt shuffle the committed deck again,
+// so neither the dealer nor any player alone decides the order.  After
+// the hand the Reveal lets anyone recompute the deck with VerifyDeck.
+type FairDealer struct {
+	mu          sync.Mutex
+	options     []func(*Config)
+	serverSeed  []byte
+	clientSeeds []string
+	last        Reveal
+	lastSeed    []byte
+	shuffles    int
+}
+
+// NewFairDealer returns a provably fair dealer of the cards configured by
+// the options.  The same options must be given to VerifyDeck.
+func NewFairDealer(options ...func(*Config)) *FairDealer {
+	d := &FairDealer{options: options}
+	d.nextSeed()
+	return d
+}
+
+// Commitment returns the commitment of the next deck, which should be
+// published before client seeds are collected.
+func (d *FairDealer) Commitment() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return commitment(d.serverSeed, committedDeck(d.serverSeed, d.options))
+}
+
+// AddClientSeed mixes a player's seed into the next deck.
+func (d *FairDealer) AddClientSeed(seed string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.clientSeeds = append(d.clientSeeds, seed)
+}
+
+// Deck implements the Dealer interface.  It deals the committed deck
+// shuffled by the client seeds and commits to a new server seed for the
+// following deck.
+func (d *FairDealer) Deck() *Deck {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	committed := committedDeck(d.serverSeed, d.options)
+	d.last = Reveal{
+		Commitment:  commitment(d.serverSeed, committed),
+		ServerSeed:  hex.EncodeToString(d.serverSeed),
+		ClientSeeds: d.clientSeeds,
+	}
+	deck := mixClientSeeds(committed, d.serverSeed, d.clientSeeds)
+	d.lastSeed, d.shuffles = d.serverSeed, 0
+	d.nextSeed()
+	return deck
+}
+
+// Shuffle implements the Shuffler interface.  The cards are shuffled with a
+// seed derived from the last dealt deck's server seed and the number of
+// shuffles since, so VerifyShuffle can replay them from the Reveal.
+func (d *FairDealer) Shuffle(deck *Deck) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	deck.Shuffle(shuffleRNG(d.lastSeed, d.shuffles))
+	d.shuffles++
+}
+
+// Reveal returns the material to verify the last dealt deck.  It must not
+// be published until the hand is over.
+func (d *FairDealer) Reveal() Reveal {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	r := d.last
+	r.ClientSeeds = append([]string{}, d.last.ClientSeeds...)
+	return r
+}
+
+func (d *FairDealer) nextSeed() {
+	d.serverSeed = make([]byte, 32)
+	if _, err := io.ReadFull(crand.Reader, d.serverSeed); err != nil {
+		panic("hand: crypto/rand failed: " + err.Error())
+	}
+	d.clientSeeds = nil
+}
+
+// VerifyDeck recomputes the deck dealt by a FairDealer created with the
+// same options.  An error is returned if the server seed doesn't match
+// the commitment.  The deck is returned in the order it was dealt from,
+// before any cards were popped.
+func VerifyDeck(r Reveal, options ...func(*Config)) (*Deck, error) {
+	seed, err := hex.DecodeString(r.ServerSeed)
+	if err != nil {
+		return nil, ErrInvalidServerSeed
+	}
+	committed := committedDeck(seed, options)
+	if commitment(seed, committed) != r.Commitment {
+		return nil, ErrCommitmentMismatch
+	}
+	return mixClientSeeds(committed, seed, r.ClientSeeds), nil
+}
+
+// VerifyShuffle replays the nth shuffle, counting from zero, of the cards
+// left in a deck that a FairDealer made after dealing the revealed deck.
+// The cards must be in the order they were in before the shuffle.
+func VerifyShuffle(r Reveal, n int, deck *Deck) error {
+	seed, err := hex.DecodeString(r.ServerSeed)
+	if err != nil {
+		return ErrInvalidServerSeed
+	}
+	deck.Shuffle(shuffleRNG(seed, n))
+	return nil
+}
+
+// shuffleRNG returns the RNG of the nth shuffle after the deck of the
+// server seed is dealt.
+func shuffleRNG(seed []byte, n int) RNG {
+	h := sha256.New()
+	h.Write(seed)
+	h.Write([]byte("shuffle"))
+	var c [8]byte
+	binary.BigEndian.PutUint64(c[:], uint64(n))
+	h.Write(c[:])
+	return &readerRNG{r: &hashReader{seed: h.Sum(nil)}}
+}
+
+// committedDeck returns the deck shuffled by the server seed alone.
+func committedDeck(seed []byte, options []func(*Config)) *Deck {
+	rng := &readerRNG{r: &hashReader{seed: seed}}
+	return &Deck{Cards: shuffleCards(Cards(options...), rng)}
+}
+
+func commitment(seed []byte, deck *Deck) string {
+	h := sha256.New()
+	h.Write(seed)
+	h.Write([]byte(deck.Format(Unicode)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// mixClientSeeds shuffles a copy of the deck with a seed derived from the
+// server seed and every client seed.
+func mixClientSeeds(deck *Deck, seed []byte, clientSeeds []string) *Deck {
+	h := sha256.New()
+	h.Write(seed)
+	for _, s := range clientSeeds {
+		// length prefixes keep the seeds from running together
+		var n [8]byte
+		binary.BigEndian.PutUint64(n[:], uint64(len(s)))
+		h.Write(n[:])
+		h.Write([]byte(s))
+	}
+	rng := &readerRNG{r: &hashReader{seed: h.Sum(nil)}}
+	cards := append([]*Card{}, deck.Cards...)
+	return &Deck{Cards: shuffleCards(cards, rng)}
+}
+
+// hashReader is an endless stream of the SHA-256 hashes of the seed
+// followed by a counter.
+type hashReader struct {
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+func (r *hashReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(r.buf) == 0 {
+			var c [8]byte
+			binary.BigEndian.PutUint64(c[:], r.counter)
+			r.counter++
+			sum := sha256.Sum256(append(append([]byte{}, r.seed...), c[:]...))
+			r.buf = sum[:]
+		}
+		m := copy(p[n:], r.buf)
+		r.buf = r.buf[m:]
+		n += m
+	}
+	return n, nil
+}
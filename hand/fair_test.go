@@ -0,0 +1,94 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+)
+
+func TestFairDealer(t *testing.T) {
+	d := NewFairDealer()
+	commitment := d.Commitment()
+	d.AddClientSeed("alice")
+	d.AddClientSeed("bob")
+	deck := d.Deck()
+
+	r := d.Reveal()
+	if r.Commitment != commitment {
+		t.Fatalf("Reveal().Commitment = %q; want the published %q", r.Commitment, commitment)
+	}
+	if len(r.ClientSeeds) != 2 {
+		t.Fatalf("Reveal().ClientSeeds = %v; want two seeds", r.ClientSeeds)
+	}
+	if d.Commitment() == commitment {
+		t.Fatal("the next deck has the same commitment")
+	}
+
+	verified, err := VerifyDeck(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified.String() != deck.String() {
+		t.Fatalf("VerifyDeck() = %v; want %v", verified, deck)
+	}
+
+	// the client seeds change the deck
+	r2 := r
+	r2.ClientSeeds = []string{"alice", "mallory"}
+	other, err := VerifyDeck(r2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.String() == deck.String() {
+		t.Fatal("different client seeds dealt the same deck")
+	}
+
+	// a different server seed doesn't match the commitment
+	d2 := NewFairDealer()
+	d2.Deck()
+	r3 := r
+	r3.ServerSeed = d2.Reveal().ServerSeed
+	if _, err := VerifyDeck(r3); err != ErrCommitmentMismatch {
+		t.Fatalf("VerifyDeck() error = %v; want %v", err, ErrCommitmentMismatch)
+	}
+	r3.ServerSeed = "not hex"
+	if _, err := VerifyDeck(r3); err != ErrInvalidServerSeed {
+		t.Fatalf("VerifyDeck() error = %v; want %v", err, ErrInvalidServerSeed)
+	}
+}
+
+func TestFairDealerShortDeck(t *testing.T) {
+	d := NewFairDealer(ShortDeck)
+	deck := d.Deck()
+	if l := len(deck.Cards); l != 36 {
+		t.Fatalf("short deck len = %d; want %d", l, 36)
+	}
+	if _, err := VerifyDeck(d.Reveal()); err != ErrCommitmentMismatch {
+		t.Fatalf("VerifyDeck() without ShortDeck error = %v; want %v", err, ErrCommitmentMismatch)
+	}
+	verified, err := VerifyDeck(d.Reveal(), ShortDeck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified.String() != deck.String() {
+		t.Fatalf("VerifyDeck() = %v; want %v", verified, deck)
+	}
+}
+
+func TestFairDealerShuffle(t *testing.T) {
+	d := NewFairDealer()
+	deck := d.Deck()
+	stub := &Deck{Cards: deck.PopMulti(10)}
+	replay := &Deck{Cards: append([]*Card{}, stub.Cards...)}
+	d.Shuffle(stub)
+
+	if err := VerifyShuffle(d.Reveal(), 0, replay); err != nil {
+		t.Fatal(err)
+	}
+	if replay.String() != stub.String() {
+		t.Fatalf("VerifyShuffle() = %v; want %v", replay, stub)
+	}
+	if err := VerifyShuffle(d.Reveal(), 1, replay); err != nil || replay.String() == stub.String() {
+		t.Fatalf("VerifyShuffle() of the second shuffle = %v, %v; want another order", replay, err)
+	}
+}
@@ -0,0 +1,13 @@
+package hand
+
+// NewFront forms the three card front hand of open face Chinese poker,
+// or any hand of three or fewer cards.  Only pairs and three of a kind
+// count.  Front hands compare with five card hands as if the missing
+// cards were lower than any rank, so K-K-Q loses to K-K-Q-3-2 and beats
+// K-K-J-T-9.  NewFront panics if there are more than three cards.
+func NewFront(cards []*Card) *Hand {
+	if len(cards) > 3 {
+		panic("hand: front hands have at most three cards")
+	}
+	return New(cards)
+}
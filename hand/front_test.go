@@ -0,0 +1,68 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+func TestNewFront(t *testing.T) {
+	t.Parallel()
+	frontTests := []struct {
+		Cards       []string
+		Ranking     Ranking
+		Description string
+	}{
+		{[]string{"Ks", "Kd", "Qh"}, Pair, "pair of kings"},
+		{[]string{"7s", "7d", "7h"}, ThreeOfAKind, "three of a kind sevens"},
+		{[]string{"As", "Ks", "Qs"}, HighCard, "high card ace high"},
+		{[]string{"4s", "3s", "2s"}, HighCard, "high card four high"},
+	}
+	for _, test := range frontTests {
+		h := NewFront(pokertest.Cards(test.Cards...))
+		if h.Ranking() != test.Ranking || h.Description() != test.Description {
+			t.Errorf("NewFront(%v) = %v; want %v %q", test.Cards, h, test.Ranking, test.Description)
+		}
+	}
+}
+
+func TestFrontComparesWithFiveCards(t *testing.T) {
+	t.Parallel()
+	front := NewFront(pokertest.Cards("Ks", "Kd", "Qh"))
+	compareTests := []struct {
+		Cards []string
+		Sign  int
+	}{
+		{[]string{"Kh", "Kc", "Qs", "3d", "2c"}, -1},
+		{[]string{"Kh", "Kc", "Js", "Td", "9c"}, 1},
+		{[]string{"Qh", "Qc", "As", "Kd", "Jc"}, 1},
+		{[]string{"2h", "2c", "3s", "3d", "4c"}, -1},
+	}
+	for _, test := range compareTests {
+		other := New(pokertest.Cards(test.Cards...))
+		if c := front.CompareTo(other); sign(c) != test.Sign {
+			t.Errorf("%v CompareTo(%v) = %d; want sign %d", front, other, c, test.Sign)
+		}
+	}
+}
+
+func TestNewFrontPanics(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("NewFront of four cards should panic")
+		}
+	}()
+	NewFront(pokertest.Cards("As", "Ks", "Qs", "Js"))
+}
+
+func sign(i int) int {
+	switch {
+	case i < 0:
+		return -1
+	case i > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,95 @@
+//go:build ignore
+// +build ignore
+
+// gen_preflop estimates the preflop all in equity of every starting hand
+// and writes the tables to preflop_autogen.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"math"
+
+	"github.com/rolends1986/poker/hand"
+)
+
+const (
+	randomTrials  = 400000
+	matchupTrials = 25000
+)
+
+func main() {
+	hands := hand.StartingHands()
+	ranges := []*hand.Range{}
+	for _, h := range hands {
+		combos := []hand.Combo{}
+		for _, cards := range h.Combos() {
+			combos = append(combos, hand.Combo{Cards: cards, Weight: 1})
+		}
+		ranges = append(ranges, hand.NewRange(combos...))
+	}
+
+	random := []hand.Combo{}
+	cards := hand.Cards()
+	for i, c1 := range cards {
+		for _, c2 := range cards[i+1:] {
+			random = append(random, hand.Combo{Cards: []*hand.Card{c1, c2}, Weight: 1})
+		}
+	}
+	randomRange := hand.NewRange(random...)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// generated by go run gen_preflop.go; DO NOT EDIT\n\n")
+	fmt.Fprintf(buf, "package hand\n\n")
+	fmt.Fprintf(buf, "// preflopEquity is the equity of each starting hand against a random\n")
+	fmt.Fprintf(buf, "// hand in hundredths of a percent from %d sampled deals.\n", randomTrials)
+	fmt.Fprintf(buf, "var preflopEquity = [NumStartingHands]uint16{")
+	for i, h := range hands {
+		if i%13 == 0 {
+			fmt.Fprintf(buf, "\n")
+		}
+		e := equity(ranges[i], randomRange, randomTrials, int64(h))
+		fmt.Fprintf(buf, "%d, ", e)
+	}
+	fmt.Fprintf(buf, "\n}\n\n")
+
+	fmt.Fprintf(buf, "// preflopMatchups is the equity of each starting hand against each\n")
+	fmt.Fprintf(buf, "// later starting hand in hundredths of a percent from %d sampled\n", matchupTrials)
+	fmt.Fprintf(buf, "// deals, indexed by matchupIndex.\n")
+	fmt.Fprintf(buf, "var preflopMatchups = [NumStartingHands * (NumStartingHands - 1) / 2]uint16{")
+	n := 0
+	for i := range hands {
+		for j := i + 1; j < len(hands); j++ {
+			if n%13 == 0 {
+				fmt.Fprintf(buf, "\n")
+			}
+			seed := int64(i*hand.NumStartingHands + j)
+			fmt.Fprintf(buf, "%d, ", equity(ranges[i], ranges[j], matchupTrials, seed))
+			n++
+		}
+		log.Printf("%v done", hands[i])
+	}
+	fmt.Fprintf(buf, "\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("preflop_autogen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// equity returns the equity of the first range against the second in
+// hundredths of a percent.
+func equity(r1, r2 *hand.Range, trials int, seed int64) uint16 {
+	result, err := hand.RangeEquity([]*hand.Range{r1, r2}, nil, nil,
+		hand.EquityTrials(trials), hand.EquitySeed(seed), hand.EquityMaxEnumerations(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return uint16(math.Round(result.Players[0].Equity * 100))
+}
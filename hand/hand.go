@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"sort"
 	"strings"
-
-	"github.com/rolends1986/poker/util"
 )
 
 // A Ranking is one of the ten possible hand rankings that determine the
@@ -56,6 +54,11 @@ const (
 	// of the same suit.
 	// Ex: A♥ K♥ Q♥ J♥ T♥
 	RoyalFlush
+
+	// FiveOfAKind represents a hand composed of five cards of the same rank,
+	// which is only possible with wild cards.
+	// Ex: A♠ A♣ A♦ A♥ 🃏
+	FiveOfAKind
 )
 
 // Sorting is the sorting used to determine which hand is
@@ -87,6 +90,12 @@ type Config struct {
 	ignoreStraights bool
 	ignoreFlushes   bool
 	aceIsLow        bool
+	noWheel         bool
+	badugi          bool
+	shortDeck       bool
+	lowestRank      Rank
+	jokers          int
+	wild            func(*Card) bool
 }
 
 // Low configures NewHand to select the lowest hand in which aces
@@ -104,33 +113,77 @@ func AceToFiveLow(c *Config) {
 	c.ignoreFlushes = true
 }
 
+// DeuceToSevenLow configures NewHand to select the lowest hand in which
+// aces are always high, straights and flushes are counted and A-2-3-4-5
+// isn't a straight.  The best hand is 7-5-4-3-2 of mixed suits, which is
+// described as "seven-five low".
+func DeuceToSevenLow(c *Config) {
+	c.sorting = SortingLow
+	c.noWheel = true
+}
+
+// ShortDeck configures NewHand for short deck (six plus) hold'em in which
+// a flush beats a full house and A-6-7-8-9 is the lowest straight.  Cards
+// and NewDealer given ShortDeck build the 36 card deck without the twos
+// through fives.
+func ShortDeck(c *Config) {
+	c.shortDeck = true
+	c.lowestRank = Six
+}
+
+// Royal configures NewHand for Royal hold'em, which is played with the 20
+// tens through aces.  As in short deck a flush beats a full house, and
+// A-K-Q-J-T is the only straight.  Cards and NewDealer given Royal build
+// the 20 card deck.
+func Royal(c *Config) {
+	c.shortDeck = true
+	c.lowestRank = Ten
+}
+
 // A Hand is the highest poker hand derived from five or more cards.
 type Hand struct {
 	ranking     Ranking
 	cards       []*Card
 	description string
+	strength    int
+	input       []*Card
+	config      Config
 }
 
 // New forms a hand from the given cards and configuration
 // options.  If there are more than five cards, New will return
 // the winning hand out of all five card combinations.  If there are
 // less than five cards, blank cards will be inserted so that a value
-// can still be calculated.
+// can still be calculated.  Badugi hands are formed from at most four
+// cards and never contain blank cards.
 func New(cards []*Card, options ...func(*Config)) *Hand {
-	c := &Config{}
-	for _, option := range options {
-		option(c)
-	}
+	c := newConfig(options)
+	h := newHand(cards, c)
+	h.input = copyCards(cards)
+	h.config = c
+	return h
+}
 
-	combos := cardCombos(cards)
-	hands := []*Hand{}
-	for _, combo := range combos {
-		hand := handForFiveCards(combo, *c)
-		hands = append(hands, hand)
+func newHand(cards []*Card, c Config) *Hand {
+	if c.badugi {
+		return badugiHand(cards, c)
+	}
+	if c.hasWilds(cards) {
+		return wildHand(cards, c)
+	}
+	if len(cards) < 5 {
+		return handForFiveCards(copyCards(cards), c)
 	}
+	selected, _ := bestCombo(cards, c)
+	return handForFiveCards(selected, c)
+}
 
-	hands = Sort(c.sorting, DESC, hands...)
-	return hands[0]
+func newConfig(options []func(*Config)) Config {
+	c := Config{}
+	for _, option := range options {
+		option(&c)
+	}
+	return c
 }
 
 // Ranking returns the hand ranking of the hand.
@@ -158,73 +211,162 @@ func (h *Hand) String() string {
 // negative value if this hand loses to the other hand, and zero if the hands
 // are equal.
 func (h *Hand) CompareTo(o *Hand) int {
-	if h.Ranking() != o.Ranking() {
-		return int(h.Ranking()) - int(o.Ranking())
-	}
-	hCards := h.Cards()
-	oCards := o.Cards()
-	for i := 0; i < 5; i++ {
-		hCard, oCard := hCards[i], oCards[i]
-		hIndex, oIndex := hCard.Rank().indexOf(), oCard.Rank().indexOf()
-		if hIndex != oIndex {
-			return hIndex - oIndex
-		}
-	}
-	return 0
+	return h.strength - o.strength
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // The json format is:
-// {"ranking":9,"cards":["A♠","K♠","Q♠","J♠","T♠"],"description":"royal flush"}
+// {"ranking":4,"cards":["5♠","4♠","3♦","2♠","A♠"],"description":"high card five high","input":["A♠","2♠","3♦","4♠","5♠","K♣","K♦"],"config":{"sorting":2,"aceIsLow":true,"ignoreStraights":true,"ignoreFlushes":true}}
+// The input cards and config let UnmarshalJSON evaluate the hand again
+// exactly.  An error is returned if the hand was made with a Wild option
+// other than DeucesWild, since other predicates can't be serialized.
 func (h *Hand) MarshalJSON() ([]byte, error) {
 	if h == nil {
 		return []byte("{}"), nil
 	}
-	cards := h.Cards()
-	b, err := json.Marshal(&cards)
-	if err != nil {
-		return []byte{}, err
+	if _, err := h.config.wildMode(); err != nil {
+		return nil, err
+	}
+	m := &handJSON{
+		Ranking:     h.Ranking(),
+		Cards:       h.Cards(),
+		Description: h.Description(),
+		Input:       h.input,
+		Config:      h.config.ConfigJSON(),
 	}
-	const format = `{"ranking":%d,"cards":%v,"description":"%v"}`
-	s := fmt.Sprintf(format, h.Ranking(), string(b), h.Description())
-	return []byte(s), nil
+	return json.Marshal(m)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-//  The json format is:
-// {"ranking":9,"cards":["A♠","K♠","Q♠","J♠","T♠"],"description":"royal flush"}
+// The hand is evaluated again from the input cards with the config.
+// Hands serialized without input cards are evaluated from their five
+// cards.
 func (h *Hand) UnmarshalJSON(b []byte) error {
-	type handJSON struct {
-		Cards []*Card
+	m := HandJSON{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
 	}
-	m := &handJSON{}
-	if err := json.Unmarshal(b, m); err != nil {
+	newHand, err := m.Hand()
+	if err != nil {
 		return err
 	}
-	newHand := New(m.Cards)
-	h.cards = newHand.Cards()
-	h.ranking = newHand.Ranking()
-	h.description = newHand.Description()
+	*h = *newHand
 	return nil
 }
 
+type handJSON struct {
+	Ranking     Ranking    `json:"ranking"`
+	Cards       []*Card    `json:"cards"`
+	Description string     `json:"description"`
+	Input       []*Card    `json:"input,omitempty"`
+	Config      ConfigJSON `json:"config"`
+}
+
+// ConfigJSON is the serializable form of a Config.  A Wild option is
+// included by name, which is "deuces" for DeucesWild.  Other wild
+// predicates are left out since functions can't be serialized.
+type ConfigJSON struct {
+	Sorting         Sorting `json:"sorting,omitempty" bson:"sorting,omitempty"`
+	AceIsLow        bool    `json:"aceIsLow,omitempty" bson:"aceIsLow,omitempty"`
+	IgnoreStraights bool    `json:"ignoreStraights,omitempty" bson:"ignoreStraights,omitempty"`
+	IgnoreFlushes   bool    `json:"ignoreFlushes,omitempty" bson:"ignoreFlushes,omitempty"`
+	NoWheel         bool    `json:"noWheel,omitempty" bson:"noWheel,omitempty"`
+	Badugi          bool    `json:"badugi,omitempty" bson:"badugi,omitempty"`
+	ShortDeck       bool    `json:"shortDeck,omitempty" bson:"shortDeck,omitempty"`
+	LowestRank      Rank    `json:"lowestRank,omitempty" bson:"lowestRank,omitempty"`
+	Jokers          int     `json:"jokers,omitempty" bson:"jokers,omitempty"`
+	Wild            string  `json:"wild,omitempty" bson:"wild,omitempty"`
+}
+
+// ConfigJSON returns the serializable form of the config.
+func (c Config) ConfigJSON() ConfigJSON {
+	wild, _ := c.wildMode()
+	return ConfigJSON{
+		Sorting:         c.sorting,
+		AceIsLow:        c.aceIsLow,
+		IgnoreStraights: c.ignoreStraights,
+		IgnoreFlushes:   c.ignoreFlushes,
+		NoWheel:         c.noWheel,
+		Badugi:          c.badugi,
+		ShortDeck:       c.shortDeck,
+		LowestRank:      c.lowestRank,
+		Jokers:          c.jokers,
+		Wild:            wild,
+	}
+}
+
+// Option returns an option that configures a Config like the one
+// serialized.
+func (j ConfigJSON) Option() func(*Config) {
+	return func(c *Config) {
+		c.sorting = j.Sorting
+		c.aceIsLow = j.AceIsLow
+		c.ignoreStraights = j.IgnoreStraights
+		c.ignoreFlushes = j.IgnoreFlushes
+		c.noWheel = j.NoWheel
+		c.badugi = j.Badugi
+		c.shortDeck = j.ShortDeck
+		c.lowestRank = j.LowestRank
+		if j.ShortDeck && j.LowestRank == "" {
+			c.lowestRank = Six
+		}
+		c.jokers = j.Jokers
+		c.wild = wildModes[j.Wild]
+	}
+}
+
+// HandJSON is the serializable form of a Hand for storage such as bson.
+// Hand returns the hand it was made from.
 type HandJSON struct {
-	Ranking     Ranking  `json:"ranking" bson:"ranking"`
-	Cards       []string `json:"cards" bson:"cards"`
-	Description string   `json:"description" bson:"description"`
+	Ranking     Ranking    `json:"ranking" bson:"ranking"`
+	Cards       []string   `json:"cards" bson:"cards"`
+	Description string     `json:"description" bson:"description"`
+	Input       []string   `json:"input,omitempty" bson:"input,omitempty"`
+	Config      ConfigJSON `json:"config" bson:"config"`
 }
 
+// HandJSON returns the serializable form of the hand.
 func (h *Hand) HandJSON() HandJSON {
 	handJSON := HandJSON{
 		Ranking:     h.ranking,
 		Description: h.description,
+		Config:      h.config.ConfigJSON(),
 	}
 	for _, c := range h.cards {
-		handJSON.Cards = append(handJSON.Cards, c.String())
+		handJSON.Cards = append(handJSON.Cards, c.Format(Unicode))
+	}
+	for _, c := range h.input {
+		handJSON.Input = append(handJSON.Input, c.Format(Unicode))
 	}
 	return handJSON
 }
 
+// Hand evaluates the input cards, or the five cards if there are none,
+// with the config again.  An error is returned if a card can't be
+// parsed or the wild mode is unknown.
+func (j HandJSON) Hand() (*Hand, error) {
+	if _, ok := wildModes[j.Config.Wild]; j.Config.Wild != "" && !ok {
+		return nil, fmt.Errorf("hand: unknown wild mode %q", j.Config.Wild)
+	}
+	strs := j.Input
+	if len(strs) == 0 {
+		strs = j.Cards
+	}
+	cards := []*Card{}
+	for _, str := range strs {
+		// New inserts the blank cards again
+		if strings.Contains(str, "?") {
+			continue
+		}
+		c, err := ParseCard(str)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return New(cards, j.Config.Option()), nil
+}
+
 // Sort returns a list of hands sorted by the given sorting
 func Sort(s Sorting, o Ordering, hands ...*Hand) []*Hand {
 	handsCopy := make([]*Hand, len(hands))
@@ -262,29 +404,26 @@ func handForFiveCards(cards []*Card, c Config) *Hand {
 			return &Hand{
 				ranking:     r.r,
 				cards:       cards,
-				description: r.dFunc(cards),
+				description: r.dFunc(cards, c),
+				strength:    strength(r.r, cards, c),
 			}
 		}
 	}
 	panic("unreachable")
 }
 
-func cardCombos(cards []*Card) [][]*Card {
-	cCombo := [][]*Card{}
-	l := 5
-	if len(cards) < 5 {
-		l = len(cards)
+// strength packs the ranking and the ranks of the formed cards into
+// an integer that orders hands the same way as comparing the ranking
+// and then each card in turn.
+func strength(r Ranking, cards []*Card, c Config) int {
+	s := int(r)
+	if c.shortDeck && (r == Flush || r == FullHouse) {
+		s = int(Flush + FullHouse - r)
 	}
-	indexCombos := util.Combinations(len(cards), l)
-
-	for _, combo := range indexCombos {
-		cCards := []*Card{}
-		for _, i := range combo {
-			cCards = append(cCards, cards[i])
-		}
-		cCombo = append(cCombo, cCards)
+	for _, card := range cards {
+		s = s<<4 | card.Rank().code(c)
 	}
-	return cCombo
+	return s
 }
 
 type ranking struct {
@@ -294,14 +433,14 @@ type ranking struct {
 }
 
 type validFunc func([]*Card, Config) bool
-type descFunc func([]*Card) string
+type descFunc func([]*Card, Config) string
 
 var (
 	highCard = ranking{
 		r: HighCard,
 		vFunc: func(cards []*Card, c Config) bool {
 			flush := hasFlush(cards)
-			straight := hasStraight(cards)
+			straight := hasStraight(cards, c)
 			pairs := hasPairs(cards, []int{1, 1, 1, 1, 1})
 			if !c.ignoreStraights {
 				pairs = pairs && !straight
@@ -311,8 +450,11 @@ var (
 			}
 			return pairs
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r := cards[0].Rank()
+			if c.noWheel {
+				return fmt.Sprintf("%v-%v low", r.singularName(), cards[1].Rank().singularName())
+			}
 			return fmt.Sprintf("high card %v high", r.singularName())
 		},
 	}
@@ -322,7 +464,7 @@ var (
 		vFunc: func(cards []*Card, c Config) bool {
 			return hasPairs(cards, []int{2, 2, 1, 1, 1})
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r := cards[0].Rank()
 			return fmt.Sprintf("pair of %v", r.pluralName())
 		},
@@ -333,7 +475,7 @@ var (
 		vFunc: func(cards []*Card, c Config) bool {
 			return hasPairs(cards, []int{2, 2, 2, 2, 1})
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r1 := cards[0].Rank()
 			r2 := cards[2].Rank()
 			return fmt.Sprintf("two pair %v and %v", r1.pluralName(), r2.pluralName())
@@ -345,7 +487,7 @@ var (
 		vFunc: func(cards []*Card, c Config) bool {
 			return hasPairs(cards, []int{3, 3, 3, 1, 1})
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r := cards[0].Rank()
 			return fmt.Sprintf("three of a kind %v", r.pluralName())
 		},
@@ -358,10 +500,10 @@ var (
 				return false
 			}
 			flush := hasFlush(cards)
-			straight := hasStraight(cards)
+			straight := hasStraight(cards, c)
 			return !flush && straight
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r := cards[0].Rank()
 			return fmt.Sprintf("straight %v high", r.singularName())
 		},
@@ -375,10 +517,10 @@ var (
 			}
 
 			flush := hasFlush(cards)
-			straight := hasStraight(cards)
+			straight := hasStraight(cards, c)
 			return flush && !straight
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r1 := cards[0].Rank()
 			return fmt.Sprintf("flush %v high", r1.singularName())
 		},
@@ -389,7 +531,7 @@ var (
 		vFunc: func(cards []*Card, c Config) bool {
 			return hasPairs(cards, []int{3, 3, 3, 2, 2})
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r1 := cards[0].Rank()
 			r2 := cards[3].Rank()
 			return fmt.Sprintf("full house %v full of %v", r1.pluralName(), r2.pluralName())
@@ -401,7 +543,7 @@ var (
 		vFunc: func(cards []*Card, c Config) bool {
 			return hasPairs(cards, []int{4, 4, 4, 4, 1})
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r := cards[0].Rank()
 			return fmt.Sprintf("four of a kind %v", r.pluralName())
 		},
@@ -414,10 +556,10 @@ var (
 				return false
 			}
 			flush := hasFlush(cards)
-			straight := hasStraight(cards)
+			straight := hasStraight(cards, c)
 			return cards[0].Rank() != Ace && flush && straight
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			r := cards[0].Rank()
 			return fmt.Sprintf("straight flush %v high", r.singularName())
 		},
@@ -430,16 +572,28 @@ var (
 				return false
 			}
 			flush := hasFlush(cards)
-			straight := hasStraight(cards)
+			straight := hasStraight(cards, c)
 			return cards[0].Rank() == Ace && flush && straight
 		},
-		dFunc: func(cards []*Card) string {
+		dFunc: func(cards []*Card, c Config) string {
 			return "royal flush"
 		},
 	}
 
+	fiveOfAKind = ranking{
+		r: FiveOfAKind,
+		vFunc: func(cards []*Card, c Config) bool {
+			return hasPairs(cards, []int{5, 5, 5, 5, 5})
+		},
+		dFunc: func(cards []*Card, c Config) string {
+			r := cards[0].Rank()
+			return fmt.Sprintf("five of a kind %v", r.pluralName())
+		},
+	}
+
 	rankings = []ranking{highCard, pair, twoPair, threeOfAKind,
-		straight, flush, fullHouse, fourOfAKind, straightFlush, royalFlush}
+		straight, flush, fullHouse, fourOfAKind, straightFlush, royalFlush,
+		fiveOfAKind}
 )
 
 func formCards(cards []*Card, c Config) []*Card {
@@ -460,7 +614,7 @@ func formCards(cards []*Card, c Config) []*Card {
 
 	// form cards starting w/ most paired
 	formed := []*Card{}
-	for i := 4; i > 0; i-- {
+	for i := 5; i > 0; i-- {
 		for _, r := range ranks {
 			rCards := cardsForRank(cards, r)
 			if len(rCards) == i {
@@ -475,7 +629,10 @@ func formCards(cards []*Card, c Config) []*Card {
 		formed = append(formed, &Card{TheRank: Rank(s), TheSuit: Suit(s)})
 	}
 	// check for low straight
-	return formLowStraight(formed)
+	if c.noWheel {
+		return formed
+	}
+	return formLowStraight(formed, c)
 }
 
 func hasPairs(cards []*Card, pairNums []int) bool {
@@ -501,7 +658,7 @@ func hasFlush(cards []*Card) bool {
 	return has
 }
 
-func hasStraight(cards []*Card) bool {
+func hasStraight(cards []*Card, c Config) bool {
 	if hasBlankCards(cards) {
 		return false
 	}
@@ -512,29 +669,39 @@ func hasStraight(cards []*Card) bool {
 		straight = straight && (lastIndex == index+1)
 		lastIndex = index
 	}
-	return straight || hasLowStraight(cards)
+	return straight || (!c.noWheel && hasLowStraight(cards, c))
 }
 
-func hasLowStraight(cards []*Card) bool {
-	return cards[0].Rank() == Five &&
-		cards[1].Rank() == Four &&
-		cards[2].Rank() == Three &&
-		cards[3].Rank() == Two &&
+func hasLowStraight(cards []*Card, c Config) bool {
+	w := c.wheel()
+	return cards[0].Rank() == w[0] &&
+		cards[1].Rank() == w[1] &&
+		cards[2].Rank() == w[2] &&
+		cards[3].Rank() == w[3] &&
 		cards[4].Rank() == Ace
 }
 
-func formLowStraight(cards []*Card) []*Card {
+func formLowStraight(cards []*Card, c Config) []*Card {
+	w := c.wheel()
 	has := cards[0].Rank() == Ace &&
-		cards[1].Rank() == Five &&
-		cards[2].Rank() == Four &&
-		cards[3].Rank() == Three &&
-		cards[4].Rank() == Two
+		cards[1].Rank() == w[0] &&
+		cards[2].Rank() == w[1] &&
+		cards[3].Rank() == w[2] &&
+		cards[4].Rank() == w[3]
 	if has {
 		cards = []*Card{cards[1], cards[2], cards[3], cards[4], cards[0]}
 	}
 	return cards
 }
 
+// wheel returns the ranks that form the lowest straight with an ace.
+func (c Config) wheel() []Rank {
+	if c.shortDeck {
+		return []Rank{Nine, Eight, Seven, Six}
+	}
+	return []Rank{Five, Four, Three, Two}
+}
+
 func hasBlankCards(cards []*Card) bool {
 	for _, c := range cards {
 		if strings.Contains(string(c.Rank()), "?") {
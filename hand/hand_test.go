@@ -199,6 +199,108 @@ var optTests = []testOptionsPairs{
 		HighCard,
 		"high card six high",
 	},
+	{
+		pokertest.Cards("7h", "5s", "4s", "3s", "2d", "9c", "Kd"),
+		pokertest.Cards("7h", "5s", "4s", "3s", "2d"),
+		[]func(*Config){DeuceToSevenLow},
+		HighCard,
+		"seven-five low",
+	},
+	{
+		pokertest.Cards("As", "2s", "3h", "4d", "5c"),
+		pokertest.Cards("As", "5c", "4d", "3h", "2s"),
+		[]func(*Config){DeuceToSevenLow},
+		HighCard,
+		"ace-five low",
+	},
+	{
+		pokertest.Cards("6h", "5s", "4s", "3s", "2d"),
+		pokertest.Cards("6h", "5s", "4s", "3s", "2d"),
+		[]func(*Config){DeuceToSevenLow},
+		Straight,
+		"straight six high",
+	},
+	{
+		pokertest.Cards("8s", "6s", "4s", "3s", "2s", "8d"),
+		pokertest.Cards("8d", "6s", "4s", "3s", "2s"),
+		[]func(*Config){DeuceToSevenLow},
+		HighCard,
+		"eight-six low",
+	},
+	{
+		pokertest.Cards("As", "6d", "7s", "8h", "9c", "Kd"),
+		pokertest.Cards("9c", "8h", "7s", "6d", "As"),
+		[]func(*Config){ShortDeck},
+		Straight,
+		"straight nine high",
+	},
+}
+
+func TestRoyal(t *testing.T) {
+	// every flush is a royal flush
+	flush := New(pokertest.Cards("As", "Ts", "Js", "Ks", "Qs"), Royal)
+	if flush.Ranking() != RoyalFlush {
+		t.Fatalf("expected %v to be a royal flush", flush)
+	}
+	straight := New(pokertest.Cards("As", "Kd", "Qs", "Jh", "Tc"), Royal)
+	if straight.Ranking() != Straight {
+		t.Fatalf("expected %v to be a straight", straight)
+	}
+	cards := Cards(Royal)
+	if l := len(cards); l != 20 {
+		t.Fatalf("len(Cards(Royal)) = %d; want %d", l, 20)
+	}
+	for _, c := range cards {
+		if c.Rank() == Nine {
+			t.Fatalf("royal deck contains %v", c)
+		}
+	}
+}
+
+func TestShortDeck(t *testing.T) {
+	flush := New(pokertest.Cards("As", "Ts", "8s", "7s", "6s"), ShortDeck)
+	fullHouse := New(pokertest.Cards("Ah", "Ad", "Ac", "Kh", "Kd"), ShortDeck)
+	if flush.CompareTo(fullHouse) <= 0 {
+		t.Fatalf("expected %v to be greater than %v", flush, fullHouse)
+	}
+	straight := New(pokertest.Cards("As", "6d", "7s", "8h", "9c"), ShortDeck)
+	trips := New(pokertest.Cards("Ah", "Ad", "Ac", "Kh", "Qd"), ShortDeck)
+	if straight.CompareTo(trips) <= 0 {
+		t.Fatalf("expected %v to be greater than %v", straight, trips)
+	}
+	if l := len(Cards(ShortDeck)); l != 36 {
+		t.Fatalf("len(Cards(ShortDeck)) = %d; want %d", l, 36)
+	}
+	deck := NewDealer(ShortDeck).Deck()
+	for _, c := range deck.Cards {
+		switch c.Rank() {
+		case Two, Three, Four, Five:
+			t.Fatalf("short deck contains %v", c)
+		}
+	}
+	if l := len(deck.Cards); l != 36 {
+		t.Fatalf("short deck len = %d; want %d", l, 36)
+	}
+	deck = NewDealer().Deck()
+	deck.Reduce(ShortDeck)
+	if l := len(deck.Cards); l != 36 {
+		t.Fatalf("reduced deck len = %d; want %d", l, 36)
+	}
+}
+
+func TestDeuceToSevenLowOrder(t *testing.T) {
+	hands := []*Hand{
+		New(pokertest.Cards("7h", "6s", "4s", "3s", "2d"), DeuceToSevenLow),
+		New(pokertest.Cards("As", "5c", "4d", "3h", "2s"), DeuceToSevenLow),
+		New(pokertest.Cards("7h", "5s", "4s", "3s", "2d"), DeuceToSevenLow),
+		New(pokertest.Cards("2h", "2s", "4s", "3s", "5d"), DeuceToSevenLow),
+	}
+	sorted := Sort(SortingLow, DESC, hands...)
+	for i, j := range []int{2, 0, 1, 3} {
+		if sorted[i] != hands[j] {
+			t.Fatalf("Sort() = %v; want %v at %d", sorted[i], hands[j], i)
+		}
+	}
 }
 
 func TestHandsWithOptions(t *testing.T) {
@@ -254,10 +356,13 @@ func TestCardJSON(t *testing.T) {
 	}
 
 	// and back
-	cardCopy := KingHearts
+	cardCopy := &Card{}
 	if err := json.Unmarshal(b, cardCopy); err != nil {
 		t.Fatal(err)
 	}
+	if *cardCopy != *card {
+		t.Fatalf("json round trip = %v; want %v", cardCopy, card)
+	}
 }
 
 func BenchmarkHandCreation(b *testing.B) {
@@ -266,3 +371,66 @@ func BenchmarkHandCreation(b *testing.B) {
 		New(cards)
 	}
 }
+
+var jsonTests = []struct {
+	cards   []*Card
+	options []func(*Config)
+}{
+	{pokertest.Cards("As", "2s", "3d", "4s", "5s", "Kc", "Kd"), []func(*Config){AceToFiveLow}},
+	{pokertest.Cards("7s", "5h", "4d", "3c", "2s", "Kd"), []func(*Config){DeuceToSevenLow}},
+	{pokertest.Cards("As", "2h", "3d", "4c", "Kd"), []func(*Config){Badugi}},
+	{pokertest.Cards("As", "6h", "7d", "8c", "9s"), []func(*Config){ShortDeck}},
+	{pokertest.Cards("Ks", "Kh", "Qd"), nil},
+}
+
+func TestHandJSON(t *testing.T) {
+	t.Parallel()
+	for _, test := range jsonTests {
+		h := New(test.cards, test.options...)
+
+		b, err := json.Marshal(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hCopy := &Hand{}
+		if err := json.Unmarshal(b, hCopy); err != nil {
+			t.Fatal(err)
+		}
+		if hCopy.String() != h.String() || hCopy.CompareTo(h) != 0 {
+			t.Errorf("json round trip of %s = %v; want %v", b, hCopy, h)
+		}
+
+		hCopy, err = h.HandJSON().Hand()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hCopy.String() != h.String() || hCopy.CompareTo(h) != 0 {
+			t.Errorf("HandJSON round trip = %v; want %v", hCopy, h)
+		}
+	}
+}
+
+func TestHandJSONUnicode(t *testing.T) {
+	t.Parallel()
+	h := New(pokertest.Cards("Kd", "Ks", "Qh", "Jc", "Td"))
+	j := h.HandJSON()
+	for _, cards := range [][]string{j.Cards, j.Input} {
+		for _, c := range cards {
+			if c != "K♦" && c != "K♠" && c != "Q♥" && c != "J♣" && c != "T♦" {
+				t.Fatalf("HandJSON() cards = %v; want the Unicode notation", cards)
+			}
+		}
+	}
+}
+
+func TestHandJSONWithoutConfig(t *testing.T) {
+	t.Parallel()
+	b := []byte(`{"ranking":7,"cards":["A♠","A♥","A♦","A♣","K♠"],"description":"four of a kind aces"}`)
+	h := &Hand{}
+	if err := json.Unmarshal(b, h); err != nil {
+		t.Fatal(err)
+	}
+	if h.Ranking() != FourOfAKind || h.CompareTo(New(pokertest.Cards("As", "Ah", "Ad", "Ac", "Ks"))) != 0 {
+		t.Fatalf("Unmarshal(%s) = %v", b, h)
+	}
+}
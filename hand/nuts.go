@@ -0,0 +1,71 @@
+package hand
+
+import "sort"
+
+// A NutHand is a possible hand on a board and the hole card combinations
+// that make it.
+type NutHand struct {
+	// Hand is the hand formed by each of the hole card combinations.
+	Hand *Hand
+
+	// HoleCards are the combinations of the hole cards the rule uses
+	// that make the hand, for example two cards in hold'em and Omaha.
+	HoleCards [][]*Card
+}
+
+// Nuts returns the n best possible hands on the board, best first, under
+// the hole card rule.  Hands of equal strength are grouped together, so
+// the first NutHand is the nuts.  If n is less than one every possible
+// hand is returned.  The options configure the deck and hand ranking,
+// such as AceToFiveLow for the nut low.
+func Nuts(board []*Card, rule HoleCardRule, n int, options ...func(*Config)) []*NutHand {
+	c := newConfig(options)
+	used := NewCardSet(board...)
+	remaining := []*Card{}
+	for _, card := range Cards(options...) {
+		if !used.Contains(card) {
+			remaining = append(remaining, card)
+		}
+	}
+
+	k := rule.Use
+	if k == 0 {
+		k = rule.Cards
+	}
+	byStrength := map[int]*NutHand{}
+	for _, combo := range indexCombos(len(remaining), k) {
+		holeCards := make([]*Card, k)
+		for i, index := range combo {
+			holeCards[i] = remaining[index]
+		}
+		h := rule.Hand(holeCards, board, options...)
+		nut, ok := byStrength[h.strength]
+		if !ok {
+			nut = &NutHand{Hand: h}
+			byStrength[h.strength] = nut
+		}
+		nut.HoleCards = append(nut.HoleCards, holeCards)
+	}
+
+	nuts := make([]*NutHand, 0, len(byStrength))
+	for _, nut := range byStrength {
+		nuts = append(nuts, nut)
+	}
+	sort.Slice(nuts, func(i, j int) bool {
+		return c.better(nuts[i].Hand, nuts[j].Hand)
+	})
+	if n > 0 && n < len(nuts) {
+		nuts = nuts[:n]
+	}
+	return nuts
+}
+
+// IsNuts returns true if no hole cards make a better hand on the board
+// than the player's hole cards under the rule.
+func IsNuts(holeCards, board []*Card, rule HoleCardRule, options ...func(*Config)) bool {
+	nuts := Nuts(board, rule, 1, options...)
+	if len(nuts) == 0 {
+		return false
+	}
+	return rule.Hand(holeCards, board, options...).CompareTo(nuts[0].Hand) == 0
+}
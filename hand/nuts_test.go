@@ -0,0 +1,80 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+func TestNutsHoldem(t *testing.T) {
+	t.Parallel()
+	board := pokertest.Cards("Ah", "Kh", "7h", "2c", "9d")
+	nuts := Nuts(board, AnyTwo, 3)
+	if len(nuts) != 3 {
+		t.Fatalf("Nuts() returned %d hands; want 3", len(nuts))
+	}
+	if nuts[0].Hand.Description() != "flush ace high" {
+		t.Errorf("nuts = %v; want flush ace high", nuts[0].Hand)
+	}
+	for _, nut := range nuts[1:] {
+		if nut.Hand.CompareTo(nuts[0].Hand) >= 0 {
+			t.Errorf("%v isn't worse than the nuts %v", nut.Hand, nuts[0].Hand)
+		}
+	}
+	if !IsNuts(pokertest.Cards("Qh", "Jh"), board, AnyTwo) {
+		t.Error("Q♥J♥ should be the nuts")
+	}
+	if IsNuts(pokertest.Cards("Qh", "3h"), board, AnyTwo) {
+		t.Error("Q♥3♥ shouldn't be the nuts")
+	}
+}
+
+func TestNutsHoleCombos(t *testing.T) {
+	t.Parallel()
+	board := pokertest.Cards("Ts", "9s", "8d", "2c", "3h")
+	nuts := Nuts(board, AnyTwo, 1)
+	if nuts[0].Hand.Description() != "straight queen high" {
+		t.Fatalf("nuts = %v; want straight queen high", nuts[0].Hand)
+	}
+	// every queen and jack
+	if n := len(nuts[0].HoleCards); n != 16 {
+		t.Fatalf("nuts made by %d combos; want 16", n)
+	}
+}
+
+func TestNutsOmaha(t *testing.T) {
+	t.Parallel()
+	board := pokertest.Cards("As", "Ks", "Qs", "Js", "2c")
+	holeCards := pokertest.Cards("Ts", "3d", "4d", "5d")
+
+	// hold'em allows T♠ alone to make a royal flush
+	if !IsNuts(holeCards[:2], board, AnyTwo) {
+		t.Error("T♠3♦ should be the hold'em nuts")
+	}
+	if IsNuts(holeCards, board, ExactlyTwo) {
+		t.Error("T♠ without a second spade shouldn't be the Omaha nuts")
+	}
+	nuts := Nuts(board, ExactlyTwo, 1)
+	if nuts[0].Hand.Description() != "straight flush king high" {
+		t.Fatalf("nuts = %v; want straight flush king high", nuts[0].Hand)
+	}
+	for _, combo := range nuts[0].HoleCards {
+		if len(combo) != 2 {
+			t.Fatalf("combo %v doesn't have two cards", combo)
+		}
+	}
+}
+
+func TestNutLow(t *testing.T) {
+	t.Parallel()
+	board := pokertest.Cards("As", "2s", "7d", "Kc", "Qh")
+	nuts := Nuts(board, ExactlyTwo, 1, AceToFiveLow)
+	want := New(pokertest.Cards("7d", "4c", "3c", "2s", "As"), AceToFiveLow)
+	if nuts[0].Hand.CompareTo(want) != 0 {
+		t.Fatalf("nut low = %v; want %v", nuts[0].Hand, want)
+	}
+	if n := len(nuts[0].HoleCards); n != 16 {
+		t.Fatalf("nut low made by %d combos; want 16", n)
+	}
+}
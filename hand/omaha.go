@@ -0,0 +1,93 @@
+package hand
+
+// A HoleCardRule is how hands are formed from a player's hole cards and
+// the board.
+type HoleCardRule struct {
+	// Cards is the number of hole cards dealt to each player.
+	Cards int
+
+	// Use is the exact number of hole cards a hand must use with five
+	// minus Use board cards, or zero if any number may be used.
+	Use int
+}
+
+// Omaha returns the rule for Omaha with the number of hole cards, where
+// hands are formed from exactly two hole cards and three board cards.
+func Omaha(holeCards int) HoleCardRule {
+	return HoleCardRule{Cards: holeCards, Use: 2}
+}
+
+var (
+	// AnyTwo is the hold'em rule where hands are formed from any of two
+	// hole cards and the board.
+	AnyTwo = HoleCardRule{Cards: 2}
+
+	// ExactlyTwo is the Omaha rule where hands are formed from exactly
+	// two of four hole cards and three board cards.
+	ExactlyTwo = Omaha(4)
+
+	// FiveCardOmaha is the rule of five card Omaha, Big O and Courchevel.
+	FiveCardOmaha = Omaha(5)
+
+	// SixCardOmaha is the rule of six card Omaha.
+	SixCardOmaha = Omaha(6)
+)
+
+// Hand returns the best hand of the hole cards and board under the rule.
+// If the board has fewer cards than the rule uses, all of them are used.
+// Hands that use an exact number of hole cards are evaluated with the
+// same lookup tables as New, so the rule is fast enough for equity loops.
+func (r HoleCardRule) Hand(holeCards, board []*Card, options ...func(*Config)) *Hand {
+	if r.Use == 0 {
+		cards := append(append([]*Card{}, holeCards...), board...)
+		return New(cards, options...)
+	}
+
+	c := newConfig(options)
+	boardUse := 5 - r.Use
+	all := append(append([]*Card{}, holeCards...), board...)
+	if boardUse > 0 && len(holeCards) >= r.Use && len(board) >= boardUse && !c.badugi && !c.hasWilds(all) {
+		hole, b := encodeCards(holeCards), encodeCards(board)
+		h, bc, _ := c.evalTable().bestExactly(hole, b, r.Use, c.sorting == SortingLow)
+		selected := make([]*Card, 0, 5)
+		for _, i := range h {
+			selected = append(selected, holeCards[i])
+		}
+		for _, i := range bc {
+			selected = append(selected, board[i])
+		}
+		return New(selected, options...)
+	}
+
+	if len(board) < boardUse {
+		boardUse = len(board)
+	}
+	var best *Hand
+	cards := make([]*Card, r.Use+boardUse)
+	for _, h := range indexCombos(len(holeCards), r.Use) {
+		for i, index := range h {
+			cards[i] = holeCards[index]
+		}
+		boardCombos := indexCombos(len(board), boardUse)
+		if boardUse == 0 {
+			boardCombos = [][]int{{}}
+		}
+		for _, b := range boardCombos {
+			for i, index := range b {
+				cards[r.Use+i] = board[index]
+			}
+			if hand := New(cards, options...); best == nil || c.better(hand, best) {
+				best = hand
+			}
+		}
+	}
+	return best
+}
+
+// better returns true if h beats o in the configured sorting.
+func (c Config) better(h, o *Hand) bool {
+	if c.sorting == SortingLow {
+		return h.CompareTo(o) < 0
+	}
+	return h.CompareTo(o) > 0
+}
@@ -0,0 +1,89 @@
+package hand_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+	"github.com/rolends1986/poker/util"
+)
+
+// bruteForce returns the best hand of every combination of use hole
+// cards and five minus use board cards.
+func bruteForce(holeCards, board []*Card, use int, low bool, options ...func(*Config)) *Hand {
+	var best *Hand
+	for _, h := range util.Combinations(len(holeCards), use) {
+		for _, b := range util.Combinations(len(board), 5-use) {
+			cards := []*Card{}
+			for _, i := range h {
+				cards = append(cards, holeCards[i])
+			}
+			for _, i := range b {
+				cards = append(cards, board[i])
+			}
+			hand := New(cards, options...)
+			if best == nil || (low && hand.CompareTo(best) < 0) || (!low && hand.CompareTo(best) > 0) {
+				best = hand
+			}
+		}
+	}
+	return best
+}
+
+func TestOmahaRules(t *testing.T) {
+	t.Parallel()
+	dealer := NewSeededDealer(16)
+	for _, rule := range []HoleCardRule{ExactlyTwo, FiveCardOmaha, SixCardOmaha, {Cards: 5, Use: 3}} {
+		for i := 0; i < 200; i++ {
+			deck := dealer.Deck()
+			holeCards := deck.PopMulti(rule.Cards)
+			board := deck.PopMulti(5)
+			for _, low := range []bool{false, true} {
+				options := []func(*Config){}
+				if low {
+					options = append(options, AceToFiveLow)
+				}
+				got := rule.Hand(holeCards, board, options...)
+				want := bruteForce(holeCards, board, rule.Use, low, options...)
+				if got.CompareTo(want) != 0 {
+					t.Fatalf("%+v Hand(%v, %v) = %v; want %v", rule, holeCards, board, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestOmahaUsesExactlyTwo(t *testing.T) {
+	t.Parallel()
+	holeCards := pokertest.Cards("As", "Ks", "Qs", "Js", "9d")
+	board := pokertest.Cards("Ts", "8s", "2s", "3c", "7h")
+	h := FiveCardOmaha.Hand(holeCards, board)
+	if h.Ranking() != Flush {
+		t.Fatalf("FiveCardOmaha.Hand() = %v; want a flush", h)
+	}
+	if h := AnyTwo.Hand(holeCards[:2], board); h.Ranking() != Flush {
+		t.Fatalf("AnyTwo.Hand() = %v; want a flush", h)
+	}
+}
+
+func TestOmahaShortBoard(t *testing.T) {
+	t.Parallel()
+	holeCards := pokertest.Cards("As", "Ah", "Ks", "Kh")
+	if h := ExactlyTwo.Hand(holeCards, nil); h.Ranking() != Pair {
+		t.Fatalf("ExactlyTwo.Hand() preflop = %v; want a pair", h)
+	}
+	board := pokertest.Cards("Ad", "Kd")
+	if h := ExactlyTwo.Hand(holeCards, board); h.Ranking() != ThreeOfAKind {
+		t.Fatalf("ExactlyTwo.Hand() = %v; want three of a kind", h)
+	}
+}
+
+func BenchmarkSixCardOmaha(b *testing.B) {
+	deck := NewSeededDealer(1).Deck()
+	holeCards := deck.PopMulti(6)
+	board := deck.PopMulti(5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SixCardOmaha.Hand(holeCards, board)
+	}
+}
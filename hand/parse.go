@@ -0,0 +1,79 @@
+package hand
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseCard parses a card written in ASCII or Unicode notation such as
+// "As", "A♠", "10s" or "td".  Ranks and suit letters are case
+// insensitive.  A joker is written "🃏" or "Jk".  The returned card is
+// the shared card value such as AceSpades.
+func ParseCard(s string) (*Card, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("hand: card is empty")
+	}
+	if s == string(Joker) || strings.EqualFold(s, "jk") {
+		return JokerCard, nil
+	}
+
+	suitRune, size := utf8.DecodeLastRuneInString(s)
+	suit, ok := parseSuit(suitRune)
+	if !ok {
+		return nil, fmt.Errorf("hand: card %q has an invalid suit %q", s, suitRune)
+	}
+	rankStr := s[:len(s)-size]
+	rank, ok := parseRank(rankStr)
+	if !ok {
+		return nil, fmt.Errorf("hand: card %q has an invalid rank %q", s, rankStr)
+	}
+
+	for _, c := range allCards() {
+		if c.Rank() == rank && c.Suit() == suit {
+			return c, nil
+		}
+	}
+	panic("unreachable")
+}
+
+// ParseCards parses a list of cards separated by commas or spaces such
+// as "As Kd, 10h".  Each card is parsed by ParseCard.
+func ParseCards(s string) ([]*Card, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	cards := []*Card{}
+	for _, field := range fields {
+		c, err := ParseCard(field)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+func parseRank(s string) (Rank, bool) {
+	if s == "10" {
+		return Ten, true
+	}
+	r := Rank(strings.ToUpper(s))
+	return r, r.valid()
+}
+
+func parseSuit(r rune) (Suit, bool) {
+	switch unicode.ToLower(r) {
+	case 's', '♠', '♤':
+		return Spades, true
+	case 'h', '♥', '♡':
+		return Hearts, true
+	case 'd', '♦', '♢':
+		return Diamonds, true
+	case 'c', '♣', '♧':
+		return Clubs, true
+	}
+	return "", false
+}
@@ -0,0 +1,104 @@
+package hand_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		s    string
+		card *Card
+	}{
+		{"As", AceSpades},
+		{"A♠", AceSpades},
+		{"aS", AceSpades},
+		{"10s", TenSpades},
+		{"td", TenDiamonds},
+		{"T♦", TenDiamonds},
+		{"4♣", FourClubs},
+		{" Qh ", QueenHearts},
+		{"🃏", JokerCard},
+		{"JK", JokerCard},
+	}
+	for _, test := range tests {
+		c, err := ParseCard(test.s)
+		if err != nil {
+			t.Fatalf("ParseCard(%q) error = %v", test.s, err)
+		}
+		if c != test.card {
+			t.Fatalf("ParseCard(%q) = %v; want %v", test.s, c, test.card)
+		}
+	}
+
+	for _, s := range []string{"", "A", "1s", "Ax", "AAs", "11s", "s"} {
+		if c, err := ParseCard(s); err == nil {
+			t.Fatalf("ParseCard(%q) = %v; want error", s, c)
+		}
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	cards, err := ParseCards("As Kd, 10h,2♣")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Card{AceSpades, KingDiamonds, TenHearts, TwoClubs}
+	if len(cards) != len(want) {
+		t.Fatalf("ParseCards() = %v; want %v", cards, want)
+	}
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Fatalf("ParseCards() = %v; want %v", cards, want)
+		}
+	}
+
+	_, err = ParseCards("As Kx")
+	if err == nil || !strings.Contains(err.Error(), `"Kx"`) {
+		t.Fatalf("ParseCards() error = %v; want an error naming \"Kx\"", err)
+	}
+}
+
+func TestASCIINotation(t *testing.T) {
+	t.Parallel()
+	if s := AceSpades.Format(ASCII); s != "As" {
+		t.Fatalf("Format(ASCII) = %q; want %q", s, "As")
+	}
+	if s := JokerCard.Format(ASCII); s != "Jk" {
+		t.Fatalf("Format(ASCII) = %q; want %q", s, "Jk")
+	}
+	if s := Hearts.Format(ASCII); s != "h" {
+		t.Fatalf("Format(ASCII) = %q; want %q", s, "h")
+	}
+	deck := &Deck{Cards: []*Card{AceSpades, TenHearts}}
+	if s := deck.Format(ASCII); s != "As,Th" {
+		t.Fatalf("Format(ASCII) = %q; want %q", s, "As,Th")
+	}
+	if s := deck.String(); s != "A♠,T♥" {
+		t.Fatalf("String() = %q; want %q", s, "A♠,T♥")
+	}
+
+	// serialization always uses the Unicode notation
+	b, err := deck.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "A♠,T♥" {
+		t.Fatalf("MarshalText() = %q; want %q", b, "A♠,T♥")
+	}
+	if s := AceSpades.Format(Unicode); s != "A♠" {
+		t.Fatalf("Format(Unicode) = %q; want %q", s, "A♠")
+	}
+}
+
+func TestUnmarshalTextASCII(t *testing.T) {
+	c := &Card{}
+	if err := c.UnmarshalText([]byte("Ks")); err != nil {
+		t.Fatal(err)
+	}
+	if *c != *KingSpades {
+		t.Fatalf("UnmarshalText() = %v; want %v", c, KingSpades)
+	}
+}
@@ -0,0 +1,1119 @@
+// generated by go run gen_preflop.go; DO NOT EDIT
+
+package hand
+
+// preflopEquity is the equity of each starting hand against a random
+// hand in hundredths of a percent from 400000 sampled deals.
+var preflopEquity = [NumStartingHands]uint16{
+	8526, 6693, 6616, 6556, 6467, 6283, 6193, 6093, 5990, 5991, 5900, 5830, 5723,
+	6529, 8244, 6329, 6247, 6191, 5994, 5822, 5741, 5657, 5570, 5488, 5388, 5318,
+	6455, 6152, 7995, 6027, 5943, 5784, 5599, 5420, 5363, 5295, 5197, 5102, 5030,
+	6370, 6057, 5809, 7746, 5760, 5564, 5403, 5232, 5066, 5007, 4912, 4827, 4730,
+	6283, 5968, 5734, 5519, 7492, 5420, 5237, 5061, 4889, 4720, 4640, 4582, 4489,
+	6086, 5781, 5535, 5325, 5154, 7205, 5078, 4911, 4752, 4575, 4395, 4322, 4247,
+	5984, 5599, 5365, 5147, 4964, 4801, 6911, 4806, 4620, 4443, 4273, 4091, 4018,
+	5876, 5514, 5182, 4969, 4791, 4630, 4505, 6606, 4530, 4366, 4181, 4001, 3807,
+	5767, 5424, 5091, 4783, 4620, 4433, 4327, 4226, 6326, 4329, 4139, 3954, 3773,
+	5772, 5327, 5015, 4713, 4432, 4273, 4153, 4050, 4000, 6038, 4149, 3965, 3773,
+	5658, 5242, 4901, 4624, 4339, 4066, 3949, 3856, 3802, 3814, 5713, 3864, 3679,
+	5591, 5128, 4830, 4534, 4255, 3996, 3744, 3656, 3609, 3634, 3498, 5376, 3593,
+	5493, 5043, 4730, 4428, 4162, 3914, 3696, 3458, 3401, 3432, 3327, 3222, 5036,
+}
+
+// preflopMatchups is the equity of each starting hand against each
+// later starting hand in hundredths of a percent from 25000 sampled
+// deals, indexed by matchupIndex.
+var preflopMatchups = [NumStartingHands * (NumStartingHands - 1) / 2]uint16{
+	8771, 8757, 8688, 8671, 8809, 8802, 8817, 8809, 8667, 8693, 8764, 8807, 9304,
+	8200, 8308, 8216, 8231, 8226, 8368, 8321, 8389, 8329, 8468, 8463, 8440, 9306,
+	8727, 8160, 8036, 8031, 8095, 8218, 8363, 8364, 8292, 8356, 8384, 8428, 9215,
+	8685, 8495, 8156, 7848, 7980, 8095, 8190, 8295, 8279, 8365, 8358, 8407, 9172,
+	8631, 8463, 8278, 8047, 7779, 7919, 8027, 8114, 8301, 8248, 8321, 8365, 9382,
+	8688, 8543, 8310, 8173, 8087, 7767, 7906, 8035, 8244, 8328, 8389, 8411, 9338,
+	8802, 8618, 8499, 8269, 8192, 8057, 7771, 7829, 8043, 8210, 8317, 8318, 9308,
+	8740, 8777, 8580, 8414, 8306, 8122, 8034, 7745, 7883, 8070, 8226, 8406, 9359,
+	8779, 8751, 8761, 8539, 8466, 8243, 8114, 8021, 7769, 7962, 8084, 8247, 9216,
+	8792, 8719, 8731, 8718, 8596, 8371, 8247, 8173, 8119, 7940, 8070, 8209, 9202,
+	8857, 8797, 8729, 8687, 8794, 8589, 8517, 8282, 8289, 8092, 8110, 8298, 9282,
+	8894, 8840, 8819, 8794, 8805, 8766, 8644, 8441, 8467, 8521, 8178, 8309, 9307,
+	8945, 8874, 8860, 8815, 8816, 8774, 8858, 8641, 8680, 8681, 8743, 8206, 7157,
+	7091, 7078, 7102, 7050, 7060, 7101, 6972, 7027, 7067, 7167, 5249, 3419, 7135,
+	7056, 7023, 7275, 7221, 7281, 7219, 7257, 7300, 7337, 7421, 7553, 7591, 4568,
+	6368, 6297, 6452, 6509, 6585, 6557, 6591, 6675, 6685, 6740, 7523, 7518, 6642,
+	4616, 6217, 6297, 6416, 6517, 6584, 6645, 6568, 6666, 6646, 7466, 7475, 6614,
+	6516, 4604, 6175, 6269, 6327, 6489, 6551, 6573, 6624, 6658, 7534, 7688, 6756,
+	6577, 6515, 4793, 6252, 6266, 6340, 6434, 6624, 6612, 6639, 7488, 7704, 6805,
+	6699, 6576, 6632, 4767, 6148, 6213, 6346, 6475, 6612, 6609, 7446, 7642, 6897,
+	6764, 6649, 6538, 6405, 4752, 6102, 6230, 6363, 6477, 6630, 7584, 7653, 6887,
+	6867, 6753, 6642, 6468, 6398, 4736, 6106, 6240, 6289, 6511, 7403, 7723, 6935,
+	6892, 6848, 6750, 6643, 6502, 6357, 4860, 6134, 6231, 6406, 7457, 7712, 6941,
+	6928, 6902, 6903, 6776, 6669, 6516, 6437, 4864, 6314, 6464, 7469, 7771, 7011,
+	6977, 6927, 6896, 6957, 6731, 6651, 6574, 6544, 4958, 6423, 7495, 7825, 7030,
+	6969, 6962, 6947, 6906, 6884, 6823, 6694, 6725, 6744, 5013, 7081, 7063, 7061,
+	7024, 7047, 7055, 6939, 6951, 6983, 7050, 2920, 3147, 7110, 6177, 6165, 6288,
+	6369, 6345, 6266, 6363, 6388, 6395, 6432, 5244, 7584, 3457, 7015, 6981, 7162,
+	7107, 7245, 7231, 7263, 7266, 7373, 7371, 7480, 6458, 7395, 4580, 6235, 6291,
+	6443, 6550, 6621, 6616, 6651, 6646, 6768, 7479, 6451, 7397, 6515, 4613, 6231,
+	6274, 6379, 6507, 6627, 6567, 6626, 6681, 7486, 6526, 7592, 6637, 6508, 4735,
+	6163, 6290, 6377, 6497, 6569, 6607, 6624, 7492, 6667, 7521, 6687, 6575, 6469,
+	4764, 6129, 6198, 6341, 6480, 6572, 6636, 7462, 6623, 7671, 6810, 6723, 6592,
+	6433, 4808, 6143, 6320, 6377, 6538, 6664, 7457, 6606, 7652, 6938, 6785, 6656,
+	6481, 6447, 4822, 6106, 6200, 6350, 6469, 7277, 6559, 7685, 6901, 6884, 6754,
+	6621, 6548, 6434, 4819, 6187, 6313, 6410, 7392, 6687, 7734, 6919, 6934, 6860,
+	6780, 6713, 6561, 6465, 4908, 6340, 6427, 7415, 6670, 7768, 6975, 6968, 6889,
+	6909, 6781, 6655, 6591, 6623, 4877, 6438, 7448, 6763, 7803, 7009, 6992, 6930,
+	6923, 6957, 6764, 6678, 6722, 6745, 5001, 6981, 6969, 7007, 6935, 6953, 6877,
+	6912, 6953, 6971, 3024, 3207, 5885, 7114, 6187, 6237, 6449, 6327, 6347, 6355,
+	6338, 6391, 6418, 3023, 6208, 3215, 7025, 6092, 6195, 6214, 6391, 6293, 6341,
+	6375, 6427, 6449, 5244, 7547, 7387, 3504, 6833, 7088, 6994, 7117, 7267, 7267,
+	7344, 7322, 7416, 7364, 6536, 6360, 7258, 4579, 6263, 6333, 6397, 6497, 6665,
+	6629, 6694, 6716, 7379, 6595, 6499, 7447, 6532, 4730, 6167, 6225, 6359, 6509,
+	6615, 6616, 6606, 7382, 6676, 6574, 7417, 6584, 6422, 4692, 6154, 6298, 6394,
+	6467, 6668, 6645, 7315, 6602, 6613, 7532, 6715, 6566, 6469, 4796, 6162, 6244,
+	6394, 6542, 6673, 7424, 6625, 6630, 7665, 6811, 6650, 6499, 6497, 4852, 6136,
+	6252, 6445, 6530, 7213, 6562, 6633, 7670, 6935, 6755, 6621, 6549, 6446, 4845,
+	6167, 6230, 6398, 7275, 6612, 6631, 7704, 6914, 6925, 6789, 6676, 6552, 6470,
+	4910, 6350, 6471, 7319, 6700, 6659, 7787, 6986, 6940, 6922, 6803, 6664, 6541,
+	6626, 4974, 6508, 7418, 6734, 6767, 7827, 7012, 6910, 6908, 6898, 6810, 6678,
+	6728, 6793, 5081, 6927, 6916, 6867, 6870, 6722, 6722, 6754, 6854, 3053, 3201,
+	5887, 5918, 7159, 6329, 6380, 6365, 6349, 6374, 6366, 6419, 6466, 3110, 6232,
+	3274, 5828, 6979, 6295, 6327, 6431, 6307, 6403, 6329, 6376, 6466, 3170, 6182,
+	6077, 3247, 6856, 6160, 6123, 6252, 6331, 6359, 6403, 6310, 6468, 5243, 7539,
+	7343, 7266, 3436, 6919, 6890, 6997, 7130, 7260, 7260, 7290, 7406, 7376, 6630,
+	6460, 6339, 7338, 4710, 6189, 6260, 6433, 6496, 6621, 6714, 6735, 7258, 6704,
+	6593, 6470, 7279, 6455, 4768, 6151, 6280, 6383, 6501, 6670, 6651, 7316, 6659,
+	6714, 6560, 7421, 6550, 6435, 4810, 6108, 6292, 6432, 6515, 6658, 7273, 6599,
+	6660, 6734, 7522, 6633, 6564, 6494, 4885, 6208, 6291, 6434, 6493, 7185, 6668,
+	6668, 6680, 7674, 6814, 6722, 6580, 6464, 4963, 6245, 6342, 6455, 7144, 6680,
+	6686, 6688, 7717, 6859, 6813, 6709, 6549, 6447, 4970, 6323, 6469, 7237, 6742,
+	6699, 6692, 7696, 6972, 6989, 6853, 6699, 6587, 6572, 4985, 6512, 7270, 6806,
+	6731, 6714, 7810, 6999, 6992, 6961, 6847, 6767, 6736, 6753, 5160, 6698, 6654,
+	6633, 6525, 6502, 6574, 6614, 3001, 3165, 5769, 5822, 5675, 7093, 6300, 6253,
+	6234, 6193, 6272, 6376, 6275, 3043, 6029, 3185, 5637, 5623, 6987, 6217, 6234,
+	6238, 6248, 6234, 6215, 6314, 3085, 6013, 5899, 3147, 5614, 6833, 6072, 6135,
+	6228, 6215, 6271, 6317, 6224, 3232, 6016, 5915, 5857, 3154, 6704, 5972, 5955,
+	6129, 6241, 6250, 6245, 6292, 5239, 7498, 7401, 7159, 7078, 3391, 6756, 6863,
+	7018, 7112, 7241, 7309, 7295, 7071, 6610, 6475, 6327, 6243, 7176, 4621, 6109,
+	6230, 6332, 6470, 6628, 6669, 7058, 6546, 6557, 6419, 6297, 7243, 6422, 4609,
+	6116, 6248, 6381, 6516, 6647, 7047, 6504, 6533, 6576, 6439, 7426, 6489, 6414,
+	4695, 6086, 6227, 6423, 6577, 6855, 6515, 6462, 6484, 6568, 7551, 6634, 6546,
+	6428, 4724, 6160, 6242, 6368, 6921, 6561, 6562, 6548, 6554, 7720, 6787, 6698,
+	6581, 6461, 4849, 6292, 6473, 6960, 6611, 6562, 6552, 6604, 7714, 6972, 6862,
+	6652, 6544, 6669, 4884, 6475, 7021, 6585, 6668, 6549, 6558, 7744, 6883, 6949,
+	6763, 6640, 6776, 6695, 4930, 6448, 6436, 6258, 6340, 6368, 6337, 3020, 3169,
+	5686, 5721, 5648, 5816, 7226, 6278, 6242, 6247, 6249, 6312, 6296, 3052, 5944,
+	3198, 5673, 5682, 5856, 7099, 6239, 6264, 6227, 6283, 6298, 6307, 3141, 5863,
+	5848, 3174, 5558, 5724, 6963, 6184, 6317, 6212, 6257, 6373, 6343, 3233, 5969,
+	5871, 5813, 3231, 5634, 6853, 6071, 6132, 6285, 6307, 6341, 6244, 3516, 6134,
+	6034, 5994, 5887, 3282, 6689, 6011, 6064, 6150, 6286, 6332, 6339, 5256, 7673,
+	7516, 7377, 7219, 7133, 3365, 6700, 6884, 7033, 7125, 7305, 7260, 6795, 6602,
+	6609, 6458, 6375, 6249, 7145, 4605, 6188, 6264, 6383, 6550, 6618, 6791, 6521,
+	6495, 6543, 6476, 6324, 7252, 6446, 4633, 6148, 6245, 6408, 6526, 6667, 6518,
+	6532, 6557, 6593, 6438, 7448, 6542, 6462, 4780, 6145, 6261, 6442, 6632, 6559,
+	6518, 6502, 6522, 6592, 7557, 6725, 6531, 6477, 4832, 6358, 6461, 6722, 6637,
+	6606, 6587, 6601, 6631, 7727, 6831, 6709, 6580, 6618, 4954, 6472, 6721, 6607,
+	6597, 6618, 6610, 6591, 7751, 6989, 6801, 6681, 6690, 6825, 4988, 6180, 5996,
+	6041, 6041, 6125, 3075, 3206, 5731, 5708, 5643, 5855, 5928, 7155, 6291, 6274,
+	6365, 6288, 6328, 3085, 6045, 3154, 5606, 5556, 5690, 5823, 7219, 6207, 6254,
+	6273, 6219, 6303, 3179, 5976, 5814, 3245, 5476, 5670, 5772, 7058, 6246, 6240,
+	6262, 6236, 6343, 3259, 5976, 5853, 5758, 3228, 5597, 5649, 6907, 6101, 6220,
+	6265, 6233, 6285, 3493, 6114, 6022, 5886, 5872, 3177, 5521, 6805, 6090, 6212,
+	6292, 6300, 6367, 3750, 6239, 6093, 5958, 5950, 5902, 3269, 6698, 5980, 6088,
+	6253, 6285, 6297, 5252, 7667, 7625, 7476, 7375, 7194, 7054, 3450, 6684, 6918,
+	7043, 7204, 7355, 6512, 6585, 6529, 6551, 6450, 6371, 6226, 7134, 4647, 6111,
+	6252, 6420, 6543, 6376, 6632, 6530, 6505, 6549, 6532, 6392, 7248, 6446, 4714,
+	6183, 6275, 6430, 6396, 6573, 6573, 6539, 6512, 6604, 6521, 7435, 6525, 6484,
+	4770, 6353, 6448, 6405, 6561, 6572, 6558, 6555, 6586, 6573, 7631, 6725, 6596,
+	6616, 4914, 6488, 6449, 6650, 6628, 6581, 6580, 6681, 6654, 7742, 6861, 6756,
+	6739, 6858, 5064, 5701, 5669, 5699, 5769, 3035, 3178, 5699, 5676, 5602, 5805,
+	5990, 5921, 7219, 6266, 6281, 6294, 6307, 3050, 5940, 3150, 5541, 5528, 5752,
+	5783, 5915, 7156, 6228, 6234, 6311, 6309, 3099, 5947, 5858, 3191, 5341, 5482,
+	5649, 5739, 7115, 6225, 6162, 6239, 6221, 3271, 5901, 5806, 5636, 3189, 5547,
+	5592, 5699, 7019, 6270, 6264, 6190, 6216, 3487, 6063, 6031, 5817, 5747, 3267,
+	5576, 5643, 6963, 6146, 6282, 6244, 6202, 3676, 6226, 6069, 5936, 5850, 5795,
+	3273, 5579, 6826, 6014, 6143, 6270, 6238, 4040, 6231, 6201, 6130, 5990, 5955,
+	5841, 3228, 6694, 6001, 6082, 6198, 6323, 5252, 7637, 7561, 7606, 7415, 7317,
+	7223, 7142, 3456, 6851, 6906, 7079, 7222, 5999, 6599, 6504, 6458, 6521, 6439,
+	6316, 6255, 7174, 4631, 6138, 6201, 6396, 5986, 6565, 6509, 6542, 6553, 6606,
+	6423, 6374, 7299, 6464, 4746, 6278, 6434, 6039, 6676, 6560, 6486, 6477, 6556,
+	6582, 6469, 7486, 6610, 6584, 4796, 6464, 6115, 6619, 6574, 6494, 6534, 6584,
+	6596, 6650, 7673, 6654, 6702, 6748, 4914, 5586, 5524, 5548, 3178, 3354, 5765,
+	5706, 5687, 5859, 5969, 5930, 5941, 7249, 6407, 6437, 6434, 3241, 5993, 3327,
+	5606, 5611, 5768, 5818, 6038, 5845, 7183, 6341, 6386, 6428, 3286, 5996, 5842,
+	3320, 5489, 5590, 5755, 5864, 5904, 7207, 6350, 6367, 6366, 3387, 5987, 5829,
+	5702, 3325, 5418, 5549, 5725, 5804, 7150, 6313, 6253, 6323, 3598, 6155, 6077,
+	5905, 5733, 3419, 5578, 5727, 5760, 7046, 6377, 6393, 6323, 3855, 6299, 6163,
+	5971, 5825, 5817, 3402, 5595, 5648, 6891, 6302, 6403, 6329, 4176, 6275, 6277,
+	6085, 5984, 5945, 5877, 3431, 5620, 6840, 6164, 6272, 6376, 4542, 6267, 6198,
+	6198, 6037, 6026, 5906, 5839, 3464, 6741, 6115, 6201, 6316, 5240, 7644, 7650,
+	7603, 7619, 7498, 7325, 7212, 7055, 3704, 6918, 7096, 7237, 5793, 6659, 6724,
+	6630, 6586, 6633, 6560, 6464, 6384, 7368, 4792, 6499, 6578, 5825, 6749, 6670,
+	6589, 6611, 6666, 6669, 6582, 6531, 7453, 6720, 4877, 6633, 5870, 6678, 6693,
+	6660, 6658, 6627, 6674, 6740, 6612, 7630, 6821, 6912, 5007, 5299, 5307, 3126,
+	3306, 5744, 5673, 5669, 5871, 5889, 5940, 5941, 6022, 7306, 6393, 6403, 3144,
+	5982, 3276, 5607, 5519, 5701, 5807, 5863, 5881, 6018, 7242, 6373, 6419, 3256,
+	6027, 5869, 3282, 5475, 5565, 5664, 5805, 5928, 6077, 7223, 6325, 6325, 3409,
+	5929, 5875, 5668, 3324, 5479, 5575, 5670, 5789, 5991, 7248, 6345, 6263, 3616,
+	6094, 6039, 5874, 5751, 3345, 5482, 5554, 5628, 5873, 7169, 6294, 6298, 3897,
+	6208, 6082, 6008, 5861, 5798, 3390, 5532, 5611, 5852, 7052, 6352, 6379, 4129,
+	6261, 6249, 6112, 5968, 5872, 5861, 3457, 5537, 5790, 6949, 6289, 6390, 4513,
+	6219, 6194, 6187, 6066, 5941, 5878, 5826, 3420, 5653, 6816, 6242, 6315, 4694,
+	6339, 6332, 6255, 6262, 6159, 6113, 6008, 5901, 3403, 6788, 6107, 6200, 5251,
+	7656, 7698, 7654, 7596, 7608, 7432, 7328, 7194, 7217, 3674, 7079, 7238, 5581,
+	6716, 6662, 6670, 6603, 6635, 6587, 6577, 6474, 6347, 7528, 4912, 6574, 5592,
+	6736, 6673, 6612, 6605, 6617, 6612, 6733, 6610, 6483, 7640, 6885, 4989, 5269,
+	3046, 3249, 5766, 5682, 5644, 5765, 5946, 5841, 5944, 6023, 6041, 7248, 6435,
+	3073, 6006, 3265, 5611, 5540, 5690, 5766, 5935, 5906, 5988, 5996, 7213, 6372,
+	3198, 5976, 5844, 3254, 5431, 5602, 5685, 5767, 5860, 5932, 6028, 7198, 6312,
+	3332, 5975, 5792, 5718, 3224, 5410, 5557, 5614, 5798, 6009, 5951, 7172, 6369,
+	3605, 6109, 5994, 5823, 5749, 3362, 5427, 5538, 5628, 5908, 5985, 7154, 6320,
+	3785, 6175, 6046, 5906, 5825, 5727, 3332, 5459, 5530, 5679, 5840, 7143, 6278,
+	4128, 6194, 6197, 6038, 5919, 5858, 5786, 3387, 5525, 5650, 5826, 7087, 6376,
+	4540, 6220, 6168, 6165, 6016, 5945, 5788, 5738, 3384, 5547, 5743, 6938, 6295,
+	4692, 6311, 6297, 6275, 6240, 6181, 5967, 5936, 5936, 3351, 5673, 6951, 6195,
+	4908, 6346, 6285, 6288, 6250, 6280, 6170, 6111, 6008, 5982, 3415, 6987, 6229,
+	5253, 7697, 7701, 7630, 7629, 7633, 7600, 7506, 7359, 7372, 7413, 3718, 7289,
+	5554, 6714, 6664, 6669, 6629, 6616, 6622, 6678, 6599, 6453, 6511, 7665, 4885,
+	3023, 3204, 5780, 5733, 5665, 5743, 5906, 5853, 5827, 5979, 6067, 5981, 7282,
+	3053, 5943, 3167, 5607, 5495, 5690, 5743, 5906, 5849, 5957, 5910, 6057, 7215,
+	3106, 5979, 5836, 3193, 5429, 5525, 5652, 5684, 5756, 5884, 5958, 5971, 7189,
+	3278, 5908, 5847, 5659, 3246, 5489, 5535, 5670, 5717, 5949, 5896, 5929, 7188,
+	3555, 6067, 5935, 5845, 5774, 3311, 5490, 5532, 5660, 5840, 5982, 5920, 7162,
+	3803, 6184, 6099, 5917, 5835, 5737, 3310, 5473, 5501, 5732, 5870, 5947, 7140,
+	4109, 6151, 6161, 6059, 5920, 5820, 5682, 3355, 5384, 5575, 5795, 5872, 7171,
+	4425, 6097, 6098, 6141, 5934, 5960, 5768, 5662, 3317, 5539, 5712, 5780, 7032,
+	4674, 6280, 6261, 6212, 6275, 6180, 6005, 5892, 5753, 3321, 5594, 5719, 7088,
+	4983, 6285, 6331, 6242, 6227, 6235, 6147, 6041, 5885, 5900, 3347, 5794, 7078,
+	5017, 6352, 6329, 6261, 6227, 6210, 6253, 6135, 5988, 6027, 6091, 3502, 7144,
+	5257, 7750, 7701, 7675, 7610, 7651, 7606, 7644, 7408, 7496, 7496, 7605, 3791,
+	3023, 7114, 7045, 6909, 7198, 7132, 7139, 7142, 7185, 7224, 7287, 7351, 7402,
+	7504, 4330, 6111, 6020, 6191, 6247, 6390, 6353, 6336, 6402, 6394, 6514, 7435,
+	7458, 6474, 4264, 5947, 6066, 6136, 6214, 6336, 6342, 6330, 6445, 6434, 7347,
+	7386, 6440, 6350, 4308, 5992, 6052, 6164, 6227, 6286, 6325, 6386, 6410, 7414,
+	7642, 6585, 6431, 6296, 4445, 6037, 6000, 6120, 6166, 6392, 6367, 6387, 7409,
+	7637, 6702, 6514, 6410, 6424, 4491, 5897, 5976, 6047, 6248, 6309, 6336, 7321,
+	7551, 6809, 6649, 6488, 6410, 6240, 4460, 5848, 5973, 6088, 6263, 6358, 7388,
+	7568, 6732, 6756, 6585, 6498, 6348, 6202, 4490, 5854, 5964, 6108, 6249, 7214,
+	7630, 6787, 6714, 6770, 6586, 6431, 6343, 6227, 4552, 5930, 6013, 6162, 7283,
+	7688, 6734, 6800, 6698, 6638, 6640, 6456, 6331, 6227, 4582, 6048, 6162, 7363,
+	7678, 6858, 6805, 6766, 6758, 6794, 6595, 6465, 6380, 6375, 4704, 6227, 7384,
+	7805, 6929, 6870, 6837, 6820, 6743, 6782, 6626, 6496, 6589, 6596, 4684, 8586,
+	8558, 8529, 8710, 8839, 8808, 8836, 8773, 8857, 8889, 8943, 7139, 9114, 8140,
+	8222, 8148, 8228, 8227, 8392, 8388, 8313, 8366, 8432, 8478, 7100, 9099, 8661,
+	8149, 8035, 8065, 8089, 8236, 8368, 8267, 8276, 8405, 8421, 7156, 9048, 8641,
+	8390, 8043, 7879, 7947, 8000, 8151, 8266, 8339, 8303, 8396, 7162, 9259, 8647,
+	8485, 8283, 8088, 7796, 7891, 8063, 8149, 8308, 8273, 8363, 7188, 9352, 8643,
+	8493, 8268, 8201, 8115, 7808, 7931, 8032, 8209, 8370, 8381, 7098, 9326, 8789,
+	8628, 8457, 8268, 8150, 8058, 7755, 7915, 8077, 8207, 8354, 7171, 9348, 8785,
+	8718, 8578, 8426, 8310, 8155, 8039, 7732, 7951, 8051, 8277, 7002, 9336, 8777,
+	8711, 8710, 8596, 8431, 8250, 8150, 8086, 7749, 7962, 8088, 7099, 9390, 8766,
+	8754, 8712, 8707, 8599, 8451, 8325, 8122, 8121, 7921, 8107, 7064, 9433, 8839,
+	8795, 8770, 8724, 8812, 8596, 8523, 8335, 8341, 8160, 8166, 7218, 9462, 8899,
+	8866, 8842, 8765, 8754, 8775, 8657, 8429, 8552, 8581, 8131, 7116, 7049, 7090,
+	7064, 6996, 7039, 7065, 7088, 7174, 7183, 2948, 5260, 3554, 7072, 7074, 7066,
+	7192, 7228, 7232, 7180, 7241, 7270, 7346, 4360, 7551, 7570, 4673, 6334, 6402,
+	6522, 6613, 6711, 6705, 6647, 6690, 6813, 4311, 7486, 7512, 6626, 4593, 6267,
+	6403, 6519, 6543, 6741, 6661, 6653, 6733, 4553, 7464, 7542, 6712, 6577, 4726,
+	6279, 6376, 6446, 6492, 6639, 6589, 6653, 4588, 7550, 7686, 6868, 6728, 6572,
+	4883, 6310, 6272, 6415, 6503, 6644, 6662, 4557, 7496, 7663, 6896, 6807, 6641,
+	6650, 4838, 6177, 6303, 6400, 6523, 6643, 4573, 7436, 7629, 6953, 6896, 6690,
+	6559, 6448, 4977, 6117, 6229, 6354, 6538, 4551, 7493, 7632, 6968, 6970, 6836,
+	6686, 6555, 6470, 4902, 6185, 6280, 6428, 4516, 7521, 7703, 7016, 6954, 6906,
+	6821, 6713, 6570, 6478, 5013, 6334, 6448, 4500, 7528, 7717, 6988, 7013, 6942,
+	6932, 6834, 6681, 6578, 6621, 5092, 6488, 4580, 7644, 7789, 7064, 7064, 7000,
+	6882, 6938, 6843, 6737, 6776, 6749, 5098, 6987, 7016, 7049, 7014, 6989, 6970,
+	7011, 7011, 7083, 4042, 3004, 3207, 7018, 6193, 6247, 6385, 6431, 6425, 6398,
+	6425, 6446, 6505, 2977, 5240, 7525, 3558, 6938, 6971, 7132, 7123, 7212, 7207,
+	7198, 7255, 7354, 4380, 7406, 6506, 7343, 4609, 6371, 6385, 6498, 6647, 6717,
+	6707, 6734, 6746, 4553, 7413, 6567, 7388, 6617, 4689, 6266, 6337, 6431, 6489,
+	6635, 6605, 6661, 4611, 7427, 6610, 7528, 6786, 6558, 4909, 6207, 6345, 6394,
+	6547, 6660, 6572, 4573, 7429, 6726, 7463, 6755, 6624, 6488, 4874, 6232, 6263,
+	6406, 6524, 6623, 4607, 7424, 6704, 7660, 6948, 6743, 6585, 6534, 4988, 6170,
+	6282, 6450, 6500, 4515, 7402, 6708, 7589, 6982, 6768, 6675, 6509, 6527, 4969,
+	6203, 6339, 6447, 4536, 7439, 6781, 7709, 7013, 7011, 6779, 6704, 6594, 6433,
+	5055, 6368, 6459, 4602, 7471, 6722, 7682, 6995, 6934, 6933, 6728, 6767, 6564,
+	6591, 5077, 6463, 4576, 7437, 6812, 7750, 7096, 6988, 6939, 6914, 6847, 6701,
+	6746, 6789, 5164, 6906, 6951, 6940, 6950, 6894, 6866, 6916, 7023, 4147, 3094,
+	3247, 5822, 7045, 6299, 6426, 6483, 6483, 6470, 6445, 6534, 6504, 4060, 3170,
+	6199, 3207, 6982, 6231, 6292, 6436, 6454, 6409, 6446, 6493, 6557, 3025, 5250,
+	7465, 7312, 3616, 6869, 7064, 6937, 7092, 7220, 7173, 7212, 7292, 4532, 7276,
+	6600, 6490, 7270, 4703, 6312, 6293, 6433, 6516, 6672, 6660, 6663, 4652, 7310,
+	6696, 6563, 7358, 6583, 4881, 6247, 6296, 6390, 6503, 6630, 6672, 4580, 7297,
+	6814, 6685, 7366, 6610, 6461, 4900, 6214, 6285, 6438, 6553, 6659, 4633, 7256,
+	6704, 6775, 7548, 6659, 6638, 6484, 4939, 6206, 6305, 6461, 6580, 4595, 7299,
+	6753, 6770, 7662, 6821, 6687, 6640, 6522, 5012, 6169, 6366, 6400, 4592, 7322,
+	6747, 6717, 7660, 6996, 6805, 6748, 6633, 6502, 5051, 6357, 6511, 4607, 7341,
+	6806, 6776, 7692, 6958, 6969, 6895, 6717, 6616, 6617, 5092, 6507, 4597, 7376,
+	6800, 6752, 7766, 6986, 6984, 6973, 6813, 6786, 6754, 6799, 5215, 6741, 6707,
+	6660, 6678, 6731, 6750, 6795, 3993, 3059, 3170, 5771, 5700, 7089, 6292, 6358,
+	6330, 6319, 6333, 6354, 6379, 4011, 3133, 5970, 3182, 5599, 6909, 6157, 6236,
+	6337, 6243, 6262, 6315, 6336, 3931, 3196, 5934, 5794, 3134, 6781, 6047, 6102,
+	6175, 6366, 6326, 6324, 6313, 2993, 5262, 7504, 7322, 7186, 3495, 6902, 6861,
+	6956, 7040, 7275, 7239, 7274, 4457, 7150, 6560, 6452, 6292, 7287, 4696, 6189,
+	6225, 6370, 6519, 6598, 6664, 4411, 7091, 6624, 6495, 6474, 7189, 6400, 4670,
+	6111, 6350, 6448, 6571, 6636, 4464, 7056, 6594, 6647, 6536, 7365, 6595, 6494,
+	4798, 6130, 6288, 6340, 6495, 4427, 7027, 6645, 6588, 6647, 7451, 6654, 6518,
+	6467, 4802, 6207, 6304, 6415, 4447, 7082, 6633, 6643, 6580, 7634, 6837, 6683,
+	6559, 6428, 4931, 6382, 6473, 4452, 7116, 6634, 6611, 6574, 7628, 6917, 6790,
+	6719, 6572, 6621, 5044, 6456, 4482, 7158, 6708, 6609, 6691, 7675, 6951, 6950,
+	6857, 6678, 6762, 6709, 5057, 6442, 6374, 6412, 6430, 6452, 6451, 3877, 2992,
+	3102, 5582, 5587, 5734, 7041, 6276, 6206, 6202, 6206, 6305, 6293, 3880, 3089,
+	5833, 3135, 5468, 5726, 6927, 6145, 6223, 6255, 6225, 6267, 6249, 3835, 3207,
+	5829, 5716, 3065, 5530, 6783, 6006, 6110, 6253, 6259, 6239, 6236, 3971, 3397,
+	6019, 5875, 5836, 3234, 6666, 5966, 6050, 6184, 6262, 6270, 6253, 2919, 5248,
+	7491, 7334, 7155, 7130, 3416, 6732, 6814, 6929, 7095, 7245, 7236, 4304, 6801,
+	6560, 6460, 6296, 6225, 7055, 4620, 6113, 6257, 6393, 6477, 6641, 4404, 6789,
+	6504, 6545, 6394, 6316, 7251, 6425, 4626, 6082, 6229, 6372, 6490, 4347, 6761,
+	6442, 6475, 6518, 6431, 7331, 6490, 6351, 4775, 6155, 6264, 6389, 4334, 6774,
+	6528, 6450, 6537, 6525, 7500, 6689, 6598, 6409, 4798, 6304, 6445, 4392, 6827,
+	6538, 6552, 6526, 6577, 7655, 6830, 6673, 6482, 6535, 4919, 6449, 4389, 6796,
+	6539, 6531, 6546, 6525, 7698, 6902, 6831, 6669, 6703, 6800, 4932, 6149, 6147,
+	6154, 6137, 6225, 3940, 3032, 3123, 5542, 5492, 5698, 5783, 7203, 6264, 6224,
+	6240, 6222, 6250, 3929, 3134, 5759, 3145, 5524, 5663, 5787, 7047, 6278, 6205,
+	6304, 6251, 6299, 3956, 3232, 5843, 5749, 3161, 5557, 5705, 6936, 6192, 6305,
+	6230, 6273, 6299, 4034, 3409, 5991, 5887, 5762, 3196, 5581, 6772, 6072, 6168,
+	6302, 6271, 6278, 3948, 3704, 6178, 6049, 5976, 5869, 3228, 6670, 6038, 6115,
+	6207, 6293, 6313, 2881, 5238, 7587, 7463, 7276, 7201, 7089, 3463, 6713, 6837,
+	6947, 7094, 7262, 4368, 6516, 6560, 6568, 6439, 6398, 6276, 7104, 4678, 6117,
+	6274, 6397, 6567, 4305, 6482, 6537, 6470, 6514, 6424, 6326, 7228, 6430, 4723,
+	6183, 6367, 6469, 4331, 6516, 6524, 6510, 6480, 6542, 6438, 7349, 6578, 6425,
+	4781, 6255, 6428, 4347, 6521, 6547, 6507, 6547, 6534, 6579, 7523, 6732, 6571,
+	6562, 4909, 6497, 4469, 6557, 6627, 6635, 6544, 6586, 6623, 7752, 6860, 6710,
+	6758, 6811, 5079, 5807, 5828, 5816, 5806, 3970, 3088, 3177, 5529, 5488, 5721,
+	5816, 6027, 7157, 6255, 6198, 6227, 6311, 3904, 3131, 5820, 3175, 5432, 5537,
+	5699, 5834, 7104, 6202, 6161, 6247, 6301, 3909, 3262, 5822, 5631, 3187, 5519,
+	5596, 5742, 6991, 6249, 6211, 6247, 6208, 4051, 3517, 5981, 5863, 5740, 3232,
+	5597, 5634, 6885, 6101, 6238, 6271, 6263, 3982, 3728, 6095, 5923, 5899, 5863,
+	3283, 5578, 6729, 6071, 6278, 6308, 6279, 4059, 4033, 6185, 6103, 5995, 5927,
+	5846, 3313, 6670, 5988, 6072, 6233, 6378, 2892, 5239, 7576, 7564, 7478, 7249,
+	7181, 7006, 3423, 6727, 6861, 6966, 7175, 4348, 6094, 6525, 6549, 6598, 6436,
+	6409, 6269, 7095, 4720, 6143, 6297, 6445, 4306, 6171, 6595, 6504, 6517, 6637,
+	6417, 6346, 7283, 6420, 4818, 6278, 6479, 4400, 6165, 6570, 6541, 6507, 6524,
+	6655, 6518, 7401, 6556, 6607, 4867, 6428, 4402, 6162, 6581, 6553, 6565, 6600,
+	6577, 6608, 7575, 6751, 6652, 6778, 4970, 5525, 5560, 5541, 3890, 3056, 3203,
+	5521, 5466, 5681, 5808, 5916, 5922, 7183, 6293, 6257, 6291, 3907, 3123, 5849,
+	3147, 5448, 5615, 5734, 5819, 5958, 7166, 6266, 6241, 6262, 3864, 3198, 5808,
+	5725, 3188, 5441, 5558, 5699, 5758, 7111, 6271, 6213, 6225, 3995, 3475, 5986,
+	5838, 5646, 3231, 5514, 5580, 5782, 6942, 6267, 6270, 6254, 3963, 3777, 6082,
+	5942, 5851, 5789, 3304, 5533, 5699, 6820, 6235, 6274, 6310, 3986, 4090, 6205,
+	6064, 5955, 5852, 5804, 3298, 5553, 6721, 6091, 6186, 6321, 3958, 4416, 6174,
+	6216, 6062, 5917, 5866, 5893, 3333, 6616, 6018, 6174, 6290, 2825, 5248, 7582,
+	7512, 7554, 7413, 7261, 7161, 7030, 3509, 6770, 6903, 7027, 4224, 5794, 6622,
+	6557, 6485, 6566, 6504, 6379, 6310, 7075, 4714, 6345, 6462, 4233, 5886, 6599,
+	6533, 6494, 6534, 6587, 6484, 6412, 7266, 6667, 4853, 6441, 4253, 5896, 6604,
+	6615, 6519, 6576, 6644, 6652, 6560, 7486, 6758, 6746, 4927, 5278, 5288, 3907,
+	2999, 3135, 5567, 5491, 5705, 5797, 5923, 5874, 5889, 7120, 6294, 6288, 3892,
+	3099, 5828, 3144, 5364, 5570, 5634, 5751, 5905, 5898, 7166, 6230, 6291, 3883,
+	3192, 5752, 5641, 3110, 5388, 5537, 5681, 5765, 5933, 7062, 6251, 6229, 4019,
+	3469, 5941, 5880, 5685, 3237, 5509, 5508, 5663, 5758, 7056, 6216, 6177, 3923,
+	3747, 6011, 5977, 5818, 5713, 3277, 5541, 5628, 5716, 7008, 6316, 6259, 3962,
+	4048, 6224, 6040, 5928, 5822, 5737, 3299, 5543, 5659, 6856, 6187, 6252, 3990,
+	4403, 6157, 6216, 6051, 5908, 5921, 5772, 3275, 5601, 6764, 6065, 6298, 3853,
+	4720, 6225, 6251, 6167, 6028, 6022, 5895, 5807, 3333, 6663, 5954, 6094, 2805,
+	5272, 7622, 7542, 7570, 7515, 7392, 7308, 7163, 7080, 3627, 6925, 7028, 4254,
+	5519, 6582, 6555, 6546, 6525, 6591, 6464, 6410, 6240, 7296, 4827, 6422, 4273,
+	5599, 6607, 6605, 6594, 6572, 6510, 6630, 6444, 6400, 7467, 6710, 4893, 5240,
+	3805, 3037, 3129, 5552, 5508, 5613, 5743, 5877, 5826, 5898, 5934, 7204, 6287,
+	3889, 3043, 5760, 3153, 5331, 5561, 5613, 5763, 5910, 5896, 5928, 7163, 6278,
+	3852, 3208, 5769, 5665, 3119, 5401, 5462, 5700, 5723, 5808, 5824, 7117, 6270,
+	4012, 3437, 5920, 5793, 5640, 3168, 5448, 5465, 5602, 5744, 5880, 7057, 6223,
+	3991, 3712, 6040, 5872, 5756, 5717, 3181, 5393, 5551, 5628, 5794, 7104, 6242,
+	3949, 4030, 6121, 6006, 5893, 5820, 5677, 3276, 5490, 5554, 5707, 6991, 6272,
+	3977, 4359, 6130, 6126, 6012, 5900, 5759, 5713, 3343, 5531, 5679, 6865, 6151,
+	3785, 4699, 6203, 6131, 6145, 5982, 5947, 5842, 5806, 3304, 5516, 6762, 6104,
+	3820, 4972, 6239, 6125, 6164, 6144, 6097, 5947, 5916, 5796, 3447, 6832, 6073,
+	2838, 5244, 7573, 7590, 7551, 7504, 7525, 7371, 7288, 7146, 7203, 3568, 7091,
+	4231, 5570, 6588, 6544, 6567, 6504, 6501, 6558, 6510, 6356, 6409, 7471, 4886,
+	3846, 2933, 3072, 5555, 5530, 5622, 5733, 5809, 5846, 5789, 5834, 5993, 7193,
+	3834, 3001, 5863, 3099, 5390, 5526, 5692, 5717, 5825, 5858, 5855, 5899, 7227,
+	3802, 3139, 5751, 5741, 3129, 5360, 5470, 5559, 5729, 5872, 5804, 5886, 7169,
+	3916, 3395, 5896, 5759, 5698, 3109, 5467, 5483, 5629, 5756, 5820, 5922, 7112,
+	3969, 3688, 6028, 5858, 5769, 5661, 3185, 5407, 5527, 5687, 5762, 5844, 7105,
+	3950, 3985, 6069, 5968, 5942, 5726, 5698, 3257, 5342, 5488, 5608, 5723, 7146,
+	3954, 4363, 6125, 6122, 6008, 5949, 5799, 5676, 3343, 5467, 5582, 5745, 6987,
+	3850, 4671, 6184, 6093, 6107, 5986, 5919, 5786, 5736, 3302, 5514, 5619, 6919,
+	3858, 4962, 6155, 6135, 6087, 6167, 6062, 5925, 5806, 5787, 3354, 5696, 6912,
+	3853, 4959, 6208, 6195, 6176, 6140, 6197, 5988, 5979, 5911, 5897, 3460, 6922,
+	2855, 5257, 7626, 7632, 7608, 7500, 7561, 7533, 7426, 7306, 7340, 7394, 3651,
+	7450, 2991, 6916, 6853, 7021, 7017, 7118, 7135, 7147, 7242, 7257, 7355, 7401,
+	6257, 7293, 4317, 5968, 6101, 6248, 6265, 6317, 6323, 6450, 6407, 6474, 7366,
+	6257, 7275, 6329, 4343, 5938, 6040, 6170, 6307, 6340, 6404, 6416, 6486, 7345,
+	6400, 7480, 6462, 6331, 4431, 5918, 6095, 6059, 6194, 6299, 6364, 6445, 7300,
+	6466, 7495, 6630, 6444, 6314, 4470, 5892, 6014, 6109, 6263, 6335, 6334, 7395,
+	6468, 7576, 6703, 6526, 6388, 6317, 4519, 5900, 6006, 6154, 6272, 6390, 7364,
+	6389, 7577, 6755, 6640, 6423, 6377, 6187, 4517, 5879, 5988, 6160, 6259, 7197,
+	6500, 7623, 6789, 6776, 6642, 6434, 6370, 6227, 4519, 5848, 5984, 6121, 7314,
+	6487, 7688, 6785, 6702, 6757, 6565, 6495, 6390, 6253, 4678, 6011, 6182, 7357,
+	6487, 7705, 6799, 6840, 6746, 6689, 6695, 6468, 6389, 6417, 4634, 6230, 7328,
+	6541, 7770, 6905, 6867, 6769, 6714, 6868, 6657, 6582, 6518, 6593, 4784, 3128,
+	6942, 6949, 7002, 7148, 7159, 7060, 7127, 7149, 7221, 7240, 4022, 7425, 7415,
+	4350, 6082, 6226, 6266, 6358, 6456, 6473, 6490, 6588, 6579, 4009, 7400, 7380,
+	6432, 4342, 6029, 6186, 6229, 6311, 6437, 6429, 6459, 6502, 4208, 7389, 7408,
+	6527, 6483, 4478, 6061, 6141, 6182, 6259, 6317, 6377, 6459, 4240, 7424, 7606,
+	6626, 6518, 6398, 4635, 6089, 6034, 6193, 6255, 6386, 6402, 4227, 7382, 7561,
+	6775, 6631, 6483, 6432, 4622, 5925, 6017, 6159, 6260, 6429, 4194, 7310, 7528,
+	6809, 6721, 6508, 6399, 6276, 4674, 5904, 6038, 6100, 6301, 4176, 7381, 7529,
+	6835, 6830, 6593, 6549, 6385, 6257, 4755, 5926, 6083, 6191, 4165, 7414, 7609,
+	6828, 6825, 6756, 6644, 6554, 6347, 6228, 4772, 6097, 6186, 4208, 7499, 7663,
+	6920, 6891, 6764, 6805, 6659, 6535, 6373, 6401, 4862, 6241, 4245, 7489, 7712,
+	6985, 6931, 6856, 6825, 6835, 6662, 6524, 6570, 6600, 4892, 8425, 8368, 8562,
+	8687, 8833, 8770, 8750, 8841, 8845, 8886, 7175, 7118, 8926, 8127, 8157, 8184,
+	8207, 8222, 8317, 8336, 8387, 8398, 8402, 7089, 7140, 8888, 8573, 8195, 7998,
+	8024, 8062, 8227, 8327, 8297, 8384, 8387, 7198, 7230, 9113, 8583, 8421, 8105,
+	7877, 7987, 8025, 8161, 8322, 8299, 8396, 7127, 7229, 9209, 8595, 8477, 8304,
+	8087, 7833, 7919, 8016, 8209, 8345, 8347, 7148, 7218, 9345, 8620, 8470, 8335,
+	8182, 8082, 7777, 7934, 8039, 8269, 8421, 7170, 7185, 9317, 8762, 8626, 8482,
+	8275, 8195, 8053, 7755, 7925, 8063, 8229, 7060, 7153, 9312, 8745, 8746, 8565,
+	8397, 8322, 8119, 8022, 7735, 7932, 8098, 7091, 7210, 9344, 8790, 8751, 8777,
+	8583, 8501, 8309, 8106, 8083, 7971, 8110, 7096, 7281, 9380, 8809, 8781, 8758,
+	8811, 8658, 8499, 8320, 8315, 8153, 8162, 7134, 7316, 9441, 8859, 8820, 8830,
+	8790, 8836, 8637, 8493, 8530, 8603, 8199, 6948, 7003, 7005, 7039, 7022, 6945,
+	7034, 7127, 7154, 3147, 2958, 5248, 3693, 7021, 7052, 7096, 7183, 7218, 7107,
+	7237, 7219, 7302, 4513, 4376, 7382, 7398, 4743, 6468, 6558, 6583, 6678, 6815,
+	6733, 6861, 6850, 4651, 4568, 7400, 7475, 6701, 4821, 6313, 6431, 6503, 6569,
+	6741, 6724, 6800, 4660, 4737, 7413, 7497, 6800, 6611, 4930, 6333, 6409, 6458,
+	6538, 6676, 6693, 4763, 4753, 7465, 7651, 6902, 6745, 6649, 4995, 6335, 6328,
+	6437, 6632, 6718, 4734, 4677, 7405, 7596, 6999, 6822, 6665, 6651, 5037, 6206,
+	6350, 6468, 6615, 4639, 4739, 7381, 7592, 7060, 6922, 6722, 6634, 6493, 5144,
+	6273, 6316, 6480, 4696, 4751, 7447, 7608, 7085, 7048, 6886, 6763, 6617, 6543,
+	5115, 6387, 6486, 4734, 4683, 7467, 7667, 7106, 7066, 6974, 6884, 6784, 6589,
+	6655, 5196, 6561, 4737, 4783, 7562, 7727, 7207, 7104, 7074, 7025, 6941, 6805,
+	6833, 6832, 5230, 6865, 6907, 6916, 6926, 6930, 6914, 6936, 6965, 4218, 4088,
+	3124, 3280, 7017, 6293, 6388, 6461, 6553, 6524, 6536, 6528, 6655, 3172, 3054,
+	5248, 7403, 3725, 6904, 6942, 7102, 7016, 7192, 7228, 7223, 7298, 4688, 4585,
+	7260, 6610, 7317, 4804, 6321, 6496, 6495, 6615, 6696, 6742, 6790, 4644, 4657,
+	7350, 6635, 7350, 6648, 4877, 6322, 6405, 6487, 6583, 6768, 6783, 4743, 4773,
+	7367, 6727, 7524, 6725, 6615, 5016, 6279, 6344, 6502, 6625, 6741, 4714, 4796,
+	7303, 6854, 7507, 6817, 6683, 6576, 5066, 6270, 6322, 6448, 6593, 4745, 4808,
+	7312, 6805, 7569, 6923, 6807, 6686, 6496, 5186, 6247, 6366, 6522, 4717, 4705,
+	7360, 6834, 7589, 7069, 6876, 6768, 6624, 6517, 5179, 6352, 6498, 4718, 4770,
+	7407, 6889, 7661, 7012, 7037, 6951, 6762, 6665, 6620, 5152, 6575, 4694, 4804,
+	7455, 6921, 7670, 7119, 7034, 7009, 6906, 6802, 6812, 6863, 5287, 6701, 6730,
+	6677, 6718, 6684, 6772, 6809, 4135, 4030, 3117, 3192, 5678, 7023, 6239, 6358,
+	6440, 6402, 6439, 6439, 6498, 4003, 3959, 3241, 5962, 3196, 6855, 6157, 6244,
+	6307, 6392, 6368, 6422, 6440, 3117, 3048, 5250, 7423, 7261, 3615, 6772, 6967,
+	6964, 6989, 7185, 7222, 7265, 4488, 4513, 7093, 6576, 6416, 7241, 4745, 6297,
+	6330, 6457, 6558, 6683, 6699, 4597, 4575, 7142, 6656, 6531, 7407, 6562, 4839,
+	6207, 6263, 6430, 6588, 6654, 4552, 4563, 7077, 6731, 6664, 7362, 6640, 6435,
+	4826, 6165, 6356, 6479, 6558, 4572, 4554, 7081, 6706, 6706, 7507, 6764, 6597,
+	6510, 5006, 6122, 6266, 6418, 4610, 4669, 7148, 6738, 6737, 7578, 6860, 6762,
+	6613, 6513, 5106, 6418, 6464, 4600, 4674, 7133, 6706, 6754, 7641, 7020, 6830,
+	6751, 6609, 6623, 5144, 6498, 4638, 4639, 7127, 6770, 6712, 7693, 7052, 7021,
+	6854, 6759, 6781, 6798, 5155, 6502, 6476, 6411, 6440, 6479, 6517, 3981, 3889,
+	3124, 3206, 5580, 5752, 6975, 6280, 6316, 6310, 6333, 6369, 6407, 3946, 3857,
+	3207, 5853, 3167, 5588, 6814, 6161, 6242, 6370, 6335, 6389, 6330, 4121, 3995,
+	3436, 6030, 5980, 3208, 6766, 6037, 6143, 6206, 6361, 6357, 6357, 2998, 3048,
+	5251, 7415, 7259, 7129, 3557, 6899, 6837, 6921, 7052, 7183, 7249, 4464, 4412,
+	6874, 6585, 6425, 6390, 7280, 4743, 6193, 6273, 6444, 6580, 6679, 4417, 4433,
+	6861, 6673, 6495, 6406, 7217, 6427, 4777, 6151, 6303, 6399, 6582, 4381, 4412,
+	6815, 6597, 6588, 6485, 7322, 6603, 6431, 4806, 6145, 6246, 6403, 4446, 4488,
+	6868, 6622, 6622, 6635, 7463, 6688, 6551, 6466, 4969, 6309, 6454, 4563, 4530,
+	6903, 6668, 6675, 6634, 7661, 6872, 6757, 6573, 6639, 5002, 6480, 4540, 4498,
+	6896, 6596, 6586, 6657, 7637, 7012, 6857, 6679, 6791, 6744, 5084, 6113, 6100,
+	6148, 6160, 6154, 3914, 3766, 3113, 3157, 5436, 5579, 5747, 7055, 6255, 6173,
+	6211, 6200, 6303, 3886, 3775, 3156, 5643, 3094, 5530, 5639, 6917, 6187, 6223,
+	6168, 6280, 6240, 3955, 3934, 3423, 5910, 5829, 3157, 5534, 6728, 6018, 6136,
+	6283, 6262, 6253, 3950, 3920, 3693, 6052, 5900, 5874, 3206, 6657, 5939, 6039,
+	6153, 6334, 6254, 2927, 2864, 5255, 7367, 7256, 7157, 7047, 3442, 6643, 6839,
+	6938, 7132, 7271, 4374, 4287, 6490, 6576, 6394, 6319, 6230, 7069, 4649, 6153,
+	6249, 6409, 6596, 4339, 4355, 6473, 6466, 6537, 6425, 6307, 7135, 6369, 4765,
+	6194, 6276, 6352, 4340, 4328, 6492, 6524, 6550, 6538, 6449, 7348, 6585, 6362,
+	4856, 6320, 6464, 4349, 4426, 6506, 6587, 6569, 6527, 6593, 7501, 6726, 6547,
+	6515, 4929, 6372, 4448, 4449, 6552, 6584, 6582, 6625, 6584, 7601, 6805, 6689,
+	6704, 6758, 5024, 5822, 5826, 5842, 5877, 3961, 3819, 3190, 3155, 5419, 5545,
+	5697, 5849, 7102, 6246, 6238, 6243, 6271, 3895, 3783, 3207, 5603, 3192, 5529,
+	5585, 5713, 6972, 6231, 6244, 6273, 6301, 4079, 3939, 3378, 5826, 5802, 3223,
+	5524, 5688, 6854, 6158, 6312, 6266, 6234, 4056, 4048, 3649, 5977, 5881, 5784,
+	3287, 5649, 6731, 6098, 6225, 6256, 6287, 3987, 4003, 4049, 6126, 5999, 6015,
+	5908, 3360, 6653, 5994, 6100, 6177, 6363, 2906, 2936, 5257, 7582, 7365, 7284,
+	7145, 7011, 3491, 6632, 6853, 6982, 7157, 4320, 4324, 6165, 6531, 6567, 6462,
+	6349, 6309, 7023, 4745, 6143, 6279, 6418, 4366, 4385, 6176, 6549, 6551, 6561,
+	6430, 6379, 7231, 6468, 4765, 6254, 6379, 4423, 4406, 6180, 6518, 6593, 6560,
+	6540, 6465, 7365, 6546, 6600, 4898, 6424, 4408, 4449, 6195, 6590, 6557, 6574,
+	6590, 6649, 7502, 6705, 6725, 6810, 5074, 5500, 5532, 5571, 3970, 3886, 3169,
+	3130, 5376, 5599, 5689, 5821, 5934, 7094, 6253, 6335, 6316, 3897, 3793, 3196,
+	5666, 3134, 5401, 5571, 5721, 5785, 7058, 6246, 6222, 6347, 4080, 3966, 3445,
+	5876, 5632, 3198, 5578, 5627, 5707, 7029, 6250, 6219, 6309, 3979, 4030, 3722,
+	5986, 5808, 5730, 3271, 5582, 5666, 6817, 6201, 6321, 6280, 4049, 4000, 4113,
+	6135, 5945, 5908, 5871, 3382, 5567, 6735, 6141, 6181, 6275, 4044, 4028, 4403,
+	6214, 6117, 5999, 5898, 5873, 3385, 6645, 5972, 6092, 6218, 2916, 2933, 5236,
+	7536, 7556, 7314, 7203, 7112, 7012, 3516, 6713, 6836, 6938, 4205, 4344, 5799,
+	6622, 6572, 6586, 6443, 6471, 6207, 7028, 4778, 6256, 6417, 4341, 4393, 5819,
+	6622, 6518, 6536, 6595, 6502, 6387, 7210, 6535, 4846, 6506, 4327, 4478, 5874,
+	6605, 6587, 6613, 6554, 6652, 6526, 7381, 6741, 6772, 4974, 5295, 5297, 3912,
+	3827, 3140, 3088, 5424, 5567, 5686, 5863, 5874, 5927, 7170, 6287, 6269, 3872,
+	3808, 3168, 5650, 3156, 5429, 5508, 5716, 5792, 5897, 7112, 6192, 6270, 3963,
+	3939, 3427, 5782, 5712, 3213, 5453, 5493, 5669, 5833, 7097, 6203, 6183, 4011,
+	4018, 3715, 5961, 5845, 5633, 3275, 5531, 5616, 5671, 7011, 6302, 6288, 4033,
+	4066, 4051, 6054, 5995, 5827, 5782, 3363, 5531, 5696, 6837, 6276, 6371, 4012,
+	3992, 4400, 6227, 6068, 5952, 5893, 5835, 3299, 5592, 6698, 6071, 6212, 3885,
+	3926, 4704, 6161, 6178, 6032, 6013, 5911, 5783, 3389, 6602, 5944, 6131, 2915,
+	2944, 5245, 7511, 7489, 7521, 7364, 7296, 7112, 7012, 3549, 6847, 6974, 4228,
+	4341, 5521, 6585, 6529, 6495, 6502, 6479, 6388, 6217, 7285, 4812, 6473, 4342,
+	4348, 5613, 6620, 6596, 6517, 6533, 6677, 6517, 6368, 7372, 6789, 4956, 5312,
+	3800, 3794, 3037, 3113, 5388, 5528, 5626, 5707, 5844, 5899, 5901, 7204, 6309,
+	3856, 3781, 3198, 5684, 3141, 5438, 5516, 5630, 5767, 5879, 5846, 7149, 6249,
+	3948, 3933, 3440, 5789, 5671, 3177, 5371, 5542, 5696, 5785, 5886, 7135, 6248,
+	3991, 4018, 3641, 5903, 5835, 5620, 3192, 5422, 5594, 5669, 5765, 7066, 6187,
+	3987, 4005, 4042, 6016, 5925, 5824, 5718, 3345, 5480, 5627, 5747, 7000, 6284,
+	3972, 4007, 4342, 6134, 6090, 5960, 5808, 5727, 3314, 5515, 5653, 6892, 6214,
+	3862, 3991, 4693, 6166, 6126, 6062, 5909, 5845, 5797, 3355, 5533, 6703, 6015,
+	3835, 3911, 4984, 6202, 6135, 6168, 6058, 6068, 5847, 5719, 3439, 6726, 6123,
+	2819, 2872, 5256, 7543, 7491, 7505, 7518, 7387, 7259, 7082, 7108, 3672, 7014,
+	4296, 4395, 5547, 6560, 6584, 6563, 6488, 6623, 6509, 6367, 6381, 7434, 4900,
+	3847, 3756, 3015, 3019, 5425, 5436, 5651, 5741, 5911, 5827, 5858, 5861, 7177,
+	3828, 3707, 3098, 5632, 3061, 5347, 5526, 5608, 5735, 5806, 5883, 5867, 7089,
+	3964, 3884, 3329, 5735, 5723, 3093, 5441, 5533, 5615, 5727, 5851, 5872, 7049,
+	3935, 3965, 3635, 5889, 5773, 5677, 3234, 5387, 5519, 5610, 5791, 5890, 7038,
+	3962, 3957, 3969, 6011, 5870, 5768, 5682, 3257, 5424, 5531, 5704, 5809, 7086,
+	4027, 3950, 4354, 6086, 5968, 5905, 5810, 5690, 3382, 5483, 5575, 5648, 7027,
+	3874, 3911, 4681, 6165, 6142, 5979, 5906, 5809, 5795, 3342, 5517, 5648, 6870,
+	3870, 3936, 4984, 6179, 6120, 6152, 6009, 5877, 5881, 5741, 3374, 5640, 6956,
+	3888, 3968, 4950, 6143, 6203, 6137, 6167, 6044, 5982, 5884, 5939, 3433, 6917,
+	2848, 2930, 5253, 7626, 7523, 7532, 7516, 7554, 7356, 7195, 7277, 7281, 3686,
+	7419, 7308, 3025, 6735, 6936, 6919, 7011, 7152, 7142, 7239, 7271, 7241, 7295,
+	6302, 6164, 7167, 4297, 6043, 6079, 6247, 6240, 6344, 6393, 6444, 6467, 7302,
+	6390, 6292, 7372, 6385, 4435, 5933, 6044, 6073, 6268, 6371, 6372, 6428, 7318,
+	6497, 6427, 7308, 6439, 6265, 4452, 5901, 5960, 6104, 6247, 6428, 6383, 7222,
+	6445, 6456, 7432, 6594, 6402, 6267, 4583, 5900, 5993, 6188, 6260, 6418, 7255,
+	6454, 6469, 7566, 6667, 6499, 6372, 6302, 4608, 5905, 6057, 6124, 6295, 7099,
+	6476, 6508, 7602, 6806, 6587, 6493, 6382, 6294, 4592, 5847, 6054, 6151, 7158,
+	6561, 6484, 7638, 6862, 6759, 6624, 6480, 6346, 6243, 4708, 6013, 6220, 7265,
+	6522, 6552, 7674, 6885, 6710, 6815, 6636, 6547, 6404, 6458, 4699, 6220, 7255,
+	6593, 6564, 7771, 6825, 6848, 6754, 6840, 6702, 6527, 6610, 6656, 4830, 7362,
+	3170, 6811, 6894, 7047, 6975, 7107, 7087, 7098, 7143, 7233, 4013, 7280, 6238,
+	7221, 4300, 6103, 6174, 6281, 6367, 6508, 6511, 6516, 6581, 4167, 7317, 6374,
+	7305, 6441, 4490, 6020, 6086, 6166, 6282, 6394, 6442, 6432, 4295, 7339, 6521,
+	7459, 6574, 6395, 4591, 5976, 6120, 6139, 6320, 6419, 6403, 4263, 7257, 6610,
+	7481, 6676, 6436, 6378, 4609, 5975, 5967, 6171, 6309, 6378, 4295, 7312, 6546,
+	7510, 6782, 6603, 6522, 6270, 4699, 5913, 6104, 6208, 6344, 4249, 7210, 6500,
+	7513, 6878, 6636, 6558, 6421, 6245, 4686, 5923, 6066, 6183, 4190, 7294, 6559,
+	7551, 6879, 6826, 6664, 6575, 6448, 6326, 4688, 6089, 6274, 4224, 7366, 6600,
+	7658, 6886, 6791, 6796, 6652, 6567, 6430, 6445, 4846, 6210, 4246, 7404, 6662,
+	7640, 6940, 6874, 6834, 6825, 6711, 6563, 6590, 6596, 4895, 3319, 6973, 6897,
+	6977, 7122, 7084, 7092, 7065, 7176, 7220, 4131, 4019, 7279, 7364, 4419, 6206,
+	6292, 6373, 6483, 6518, 6598, 6603, 6647, 4323, 4205, 7273, 7370, 6591, 4515,
+	6079, 6204, 6321, 6340, 6472, 6476, 6562, 4308, 4342, 7289, 7406, 6659, 6460,
+	4638, 6111, 6116, 6181, 6387, 6504, 6450, 4418, 4437, 7359, 7559, 6778, 6512,
+	6419, 4807, 6093, 6135, 6290, 6387, 6506, 4405, 4493, 7277, 7559, 6817, 6679,
+	6525, 6559, 4799, 5943, 6130, 6232, 6314, 4328, 4399, 7241, 7517, 7006, 6753,
+	6596, 6432, 6314, 4870, 5992, 6084, 6186, 4389, 4402, 7314, 7598, 6919, 6851,
+	6696, 6611, 6443, 6293, 4900, 6146, 6211, 4415, 4431, 7373, 7571, 7033, 6874,
+	6864, 6735, 6662, 6422, 6512, 4921, 6324, 4418, 4426, 7455, 7623, 7087, 6974,
+	6862, 6924, 6741, 6530, 6622, 6661, 5005, 8188, 8439, 8507, 8637, 8788, 8741,
+	8788, 8827, 8882, 7136, 7078, 7060, 8670, 8149, 8199, 8171, 8150, 8214, 8324,
+	8316, 8396, 8409, 7273, 7182, 7170, 8893, 8577, 8168, 8007, 8107, 8081, 8223,
+	8397, 8357, 8429, 7168, 7191, 7190, 9020, 8586, 8438, 8168, 7891, 7924, 8073,
+	8203, 8375, 8393, 7200, 7202, 7234, 9179, 8607, 8457, 8290, 8102, 7848, 7937,
+	8072, 8223, 8362, 7212, 7230, 7257, 9290, 8659, 8486, 8297, 8210, 8070, 7770,
+	7953, 8160, 8244, 7022, 7143, 7213, 9294, 8742, 8579, 8428, 8242, 8169, 8056,
+	7739, 7905, 8060, 7053, 7241, 7226, 9358, 8726, 8769, 8604, 8486, 8317, 8131,
+	8094, 7913, 8135, 7084, 7228, 7245, 9345, 8854, 8782, 8786, 8612, 8514, 8298,
+	8353, 8165, 8185, 7185, 7274, 7316, 9415, 8837, 8827, 8812, 8819, 8689, 8477,
+	8484, 8570, 8209, 6885, 6906, 6986, 6945, 6984, 6948, 6963, 7015, 3287, 3205,
+	3038, 5258, 3859, 7018, 7048, 7072, 7180, 7149, 7167, 7185, 7285, 4722, 4672,
+	4577, 7310, 7427, 4865, 6438, 6584, 6650, 6735, 6876, 6845, 6888, 4714, 4813,
+	4686, 7306, 7378, 6706, 4949, 6430, 6467, 6561, 6661, 6811, 6790, 4784, 4795,
+	4797, 7326, 7450, 6753, 6666, 5046, 6363, 6417, 6537, 6687, 6831, 4869, 4877,
+	4865, 7358, 7632, 6964, 6760, 6629, 5139, 6439, 6425, 6531, 6661, 4816, 4877,
+	4889, 7337, 7597, 7068, 6856, 6777, 6807, 5232, 6307, 6347, 6565, 4815, 4905,
+	4812, 7343, 7545, 7168, 6976, 6767, 6708, 6535, 5262, 6425, 6574, 4819, 4825,
+	4933, 7395, 7685, 7120, 7105, 6940, 6856, 6681, 6746, 5352, 6646, 4876, 4930,
+	4874, 7427, 7654, 7200, 7099, 7090, 6962, 6798, 6845, 6893, 5383, 6655, 6738,
+	6710, 6771, 6742, 6773, 6791, 4173, 4064, 3923, 3186, 3273, 6932, 6269, 6364,
+	6427, 6472, 6504, 6528, 6571, 3357, 3199, 3055, 5244, 7381, 3755, 6897, 6858,
+	7056, 7045, 7134, 7127, 7169, 4534, 4664, 4521, 7101, 6523, 7258, 4832, 6338,
+	6458, 6516, 6645, 6796, 6790, 4616, 4628, 4598, 7093, 6669, 7331, 6618, 4911,
+	6278, 6340, 6519, 6629, 6739, 4726, 4754, 4728, 7164, 6745, 7474, 6713, 6545,
+	5082, 6268, 6441, 6469, 6600, 4686, 4681, 4683, 7111, 6831, 7413, 6788, 6596,
+	6500, 5054, 6229, 6350, 6412, 4682, 4751, 4733, 7179, 6823, 7573, 6951, 6767,
+	6657, 6575, 5165, 6391, 6510, 4727, 4745, 4779, 7160, 6850, 7588, 7052, 6901,
+	6759, 6625, 6724, 5155, 6520, 4684, 4721, 4751, 7160, 6893, 7650, 7086, 7035,
+	6941, 6733, 6806, 6761, 5319, 6502, 6502, 6432, 6512, 6562, 6556, 4077, 3978,
+	3813, 3199, 3200, 5694, 6958, 6247, 6329, 6443, 6432, 6468, 6425, 4174, 4116,
+	3985, 3404, 5984, 3220, 6816, 6153, 6239, 6353, 6417, 6474, 6424, 3127, 3192,
+	3138, 5254, 7347, 7212, 3709, 6743, 6956, 6854, 7038, 7178, 7223, 4488, 4491,
+	4551, 6907, 6552, 6418, 7148, 4809, 6258, 6285, 6476, 6598, 6699, 4593, 4587,
+	4545, 6897, 6656, 6520, 7290, 6576, 4923, 6178, 6333, 6460, 6539, 4497, 4627,
+	4588, 6845, 6709, 6601, 7266, 6605, 6503, 4930, 6172, 6302, 6443, 4570, 4600,
+	4676, 6874, 6780, 6761, 7477, 6765, 6613, 6458, 5072, 6351, 6461, 4618, 4655,
+	4651, 6937, 6783, 6726, 7648, 6938, 6740, 6589, 6653, 5110, 6560, 4637, 4611,
+	4663, 6900, 6749, 6706, 7578, 7088, 6868, 6752, 6737, 6811, 5172, 6232, 6193,
+	6122, 6163, 6248, 4018, 3946, 3728, 3200, 3114, 5623, 5733, 6953, 6186, 6345,
+	6321, 6313, 6390, 4100, 3959, 3886, 3413, 5803, 3280, 5644, 6875, 6151, 6239,
+	6344, 6341, 6400, 4057, 4040, 3995, 3700, 5967, 5927, 3267, 6728, 6115, 6130,
+	6251, 6358, 6374, 3009, 3068, 3091, 5234, 7340, 7235, 7066, 3585, 6842, 6773,
+	6908, 7103, 7171, 4455, 4490, 4417, 6540, 6498, 6470, 6292, 7183, 4838, 6154,
+	6322, 6454, 6606, 4402, 4509, 4445, 6516, 6635, 6463, 6449, 7148, 6480, 4836,
+	6166, 6265, 6470, 4527, 4494, 4514, 6516, 6615, 6680, 6552, 7307, 6533, 6377,
+	4914, 6317, 6480, 4523, 4566, 4509, 6602, 6636, 6665, 6656, 7414, 6751, 6584,
+	6546, 5011, 6498, 4541, 4616, 4528, 6593, 6677, 6716, 6736, 7610, 6882, 6720,
+	6777, 6788, 5131, 5798, 5814, 5852, 5851, 3913, 3795, 3675, 3188, 3126, 5381,
+	5587, 5762, 6999, 6183, 6218, 6275, 6302, 4087, 3961, 3801, 3452, 5730, 3185,
+	5543, 5643, 6858, 6121, 6225, 6215, 6278, 3981, 3966, 3872, 3647, 5852, 5790,
+	3268, 5588, 6637, 6032, 6191, 6311, 6235, 3956, 3924, 3956, 4002, 6046, 5924,
+	5792, 3342, 6607, 5940, 6091, 6166, 6382, 2880, 2973, 2981, 5237, 7330, 7218,
+	7104, 6959, 3498, 6648, 6748, 6938, 7069, 4369, 4358, 4367, 6099, 6571, 6446,
+	6338, 6205, 6960, 4698, 6164, 6230, 6425, 4350, 4326, 4352, 6139, 6531, 6552,
+	6457, 6361, 7155, 6434, 4760, 6332, 6384, 4441, 4431, 4373, 6125, 6488, 6513,
+	6544, 6447, 7359, 6635, 6516, 4855, 6402, 4420, 4444, 4412, 6158, 6528, 6561,
+	6619, 6635, 7524, 6677, 6687, 6688, 4941, 5471, 5557, 5558, 3891, 3843, 3704,
+	3245, 3146, 5376, 5558, 5689, 5810, 7117, 6260, 6254, 6260, 4055, 4024, 3854,
+	3382, 5645, 3208, 5450, 5609, 5720, 6937, 6340, 6246, 6271, 3993, 4108, 3974,
+	3728, 5820, 5773, 3315, 5571, 5659, 6829, 6169, 6283, 6307, 4042, 4052, 4027,
+	3995, 6032, 5866, 5786, 3296, 5631, 6710, 6117, 6238, 6354, 4038, 4017, 3993,
+	4444, 6156, 6005, 5959, 5823, 3383, 6600, 6049, 6103, 6186, 2922, 2985, 2948,
+	5249, 7492, 7319, 7195, 7099, 6943, 3641, 6646, 6756, 6893, 4256, 4385, 4353,
+	5835, 6587, 6566, 6489, 6389, 6287, 6987, 4759, 6302, 6398, 4310, 4444, 4350,
+	5870, 6578, 6572, 6574, 6468, 6414, 7171, 6606, 4871, 6468, 4334, 4441, 4435,
+	5840, 6500, 6523, 6573, 6644, 6635, 7331, 6736, 6780, 5013, 5317, 5295, 3899,
+	3804, 3671, 3225, 3114, 5395, 5571, 5682, 5837, 5961, 7113, 6283, 6255, 4013,
+	3965, 3868, 3354, 5721, 3170, 5411, 5560, 5679, 5741, 7017, 6208, 6267, 3968,
+	4027, 3952, 3707, 5845, 5659, 3255, 5497, 5605, 5743, 6934, 6308, 6271, 4042,
+	3974, 3988, 3995, 5947, 5812, 5758, 3301, 5551, 5646, 6818, 6209, 6294, 4021,
+	3956, 4010, 4424, 6120, 5975, 5888, 5828, 3383, 5590, 6707, 6100, 6198, 3861,
+	3978, 3985, 4745, 6224, 6065, 5986, 5897, 5826, 3374, 6592, 5993, 6112, 2911,
+	2954, 2981, 5228, 7449, 7496, 7355, 7215, 7081, 6962, 3575, 6751, 6961, 4260,
+	4397, 4342, 5543, 6550, 6570, 6533, 6482, 6414, 6284, 7178, 4823, 6442, 4328,
+	4479, 4385, 5584, 6581, 6495, 6515, 6589, 6484, 6364, 7328, 6772, 4907, 5313,
+	3806, 3752, 3650, 3220, 3053, 5424, 5513, 5656, 5751, 5898, 5936, 7048, 6245,
+	4078, 3973, 3837, 3384, 5671, 3139, 5433, 5570, 5704, 5815, 5939, 7053, 6238,
+	3963, 3999, 3916, 3602, 5795, 5705, 3143, 5363, 5517, 5656, 5745, 7073, 6210,
+	4020, 3994, 4006, 3951, 5944, 5780, 5672, 3309, 5505, 5575, 5698, 6945, 6221,
+	3974, 3998, 4012, 4423, 6076, 5980, 5776, 5764, 3370, 5593, 5675, 6796, 6192,
+	3954, 3978, 3990, 4648, 6186, 6022, 5926, 5924, 5795, 3381, 5482, 6648, 6066,
+	3916, 3960, 3923, 4933, 6178, 6202, 6020, 5991, 5981, 5815, 3483, 6714, 6105,
+	2870, 2952, 2978, 5260, 7474, 7515, 7473, 7314, 7217, 7000, 7117, 3689, 6987,
+	4304, 4389, 4308, 5583, 6604, 6587, 6496, 6554, 6491, 6377, 6390, 7378, 4922,
+	3827, 3708, 3617, 3054, 3041, 5378, 5553, 5618, 5749, 5841, 5881, 5886, 7073,
+	4024, 3900, 3786, 3323, 5642, 3104, 5454, 5552, 5680, 5745, 5858, 5843, 7096,
+	3947, 4039, 3902, 3615, 5764, 5741, 3191, 5440, 5492, 5662, 5817, 5869, 7007,
+	3954, 3960, 3937, 3928, 5892, 5746, 5626, 3275, 5473, 5551, 5622, 5735, 7051,
+	4004, 3972, 3993, 4423, 6022, 5933, 5858, 5696, 3307, 5502, 5624, 5775, 6928,
+	3855, 3952, 3962, 4678, 6198, 6058, 5839, 5784, 5764, 3396, 5394, 5586, 6784,
+	3922, 3980, 3960, 4944, 6161, 6181, 6081, 5842, 5855, 5800, 3382, 5618, 6867,
+	3943, 3928, 3918, 4969, 6136, 6162, 6196, 6065, 5995, 5863, 5941, 3491, 6863,
+	2889, 2952, 2941, 5265, 7533, 7507, 7477, 7470, 7343, 7186, 7208, 7320, 3697,
+	7437, 7200, 7080, 3081, 6794, 6751, 6933, 7046, 7154, 7251, 7208, 7242, 7179,
+	6438, 6393, 6216, 7238, 4410, 5959, 6025, 6183, 6271, 6434, 6359, 6450, 7198,
+	6558, 6444, 6284, 7223, 6263, 4469, 5892, 6004, 6109, 6243, 6405, 6406, 7117,
+	6478, 6527, 6413, 7323, 6439, 6313, 4529, 5980, 6018, 6151, 6321, 6450, 7135,
+	6460, 6415, 6406, 7446, 6571, 6336, 6265, 4606, 5892, 6029, 6194, 6291, 7004,
+	6484, 6493, 6506, 7567, 6676, 6538, 6384, 6277, 4628, 5886, 6055, 6184, 7003,
+	6493, 6480, 6529, 7610, 6820, 6636, 6558, 6364, 6309, 4703, 6083, 6251, 7035,
+	6534, 6493, 6552, 7686, 6791, 6819, 6714, 6554, 6448, 6472, 4821, 6227, 7111,
+	6583, 6625, 6572, 7717, 6847, 6743, 6802, 6683, 6551, 6551, 6577, 4895, 7355,
+	7220, 3194, 6753, 6869, 6828, 6979, 7142, 7121, 7167, 7233, 4187, 7234, 6389,
+	6361, 7146, 4437, 6116, 6098, 6251, 6326, 6453, 6469, 6494, 4238, 7227, 6515,
+	6400, 7303, 6432, 4635, 5971, 6086, 6208, 6272, 6415, 6450, 4226, 7169, 6614,
+	6542, 7294, 6475, 6373, 4606, 5909, 6028, 6138, 6258, 6446, 4301, 7169, 6561,
+	6582, 7475, 6569, 6393, 6336, 4681, 5990, 6106, 6232, 6314, 4246, 7181, 6601,
+	6554, 7540, 6718, 6544, 6434, 6338, 4805, 5929, 6054, 6205, 4276, 7191, 6543,
+	6527, 7592, 6868, 6681, 6531, 6483, 6285, 4777, 6035, 6255, 4237, 7233, 6635,
+	6617, 7614, 6779, 6830, 6712, 6544, 6490, 6530, 4923, 6303, 4260, 7247, 6677,
+	6655, 7702, 6837, 6810, 6815, 6727, 6589, 6572, 6589, 5007, 7315, 3348, 6824,
+	6867, 6969, 6976, 7080, 7154, 7160, 7226, 4264, 4195, 7169, 6416, 7257, 4611,
+	6158, 6194, 6276, 6373, 6481, 6563, 6611, 4351, 4322, 7196, 6510, 7259, 6504,
+	4644, 6119, 6149, 6219, 6367, 6512, 6533, 4432, 4369, 7181, 6637, 7422, 6616,
+	6408, 4825, 6027, 6165, 6293, 6369, 6477, 4381, 4422, 7195, 6735, 7395, 6693,
+	6511, 6359, 4794, 6001, 6095, 6201, 6303, 4415, 4471, 7180, 6646, 7514, 6758,
+	6565, 6481, 6343, 4860, 5991, 6078, 6310, 4440, 4416, 7203, 6657, 7521, 6935,
+	6744, 6551, 6475, 6347, 4940, 6086, 6288, 4366, 4432, 7256, 6724, 7597, 6953,
+	6902, 6787, 6594, 6511, 6524, 4950, 6292, 4398, 4502, 7337, 6758, 7631, 6978,
+	6890, 6922, 6756, 6611, 6622, 6688, 5007, 3475, 6909, 6924, 6942, 7114, 7040,
+	7114, 7123, 7178, 4420, 4339, 4203, 7177, 7307, 4659, 6220, 6350, 6367, 6469,
+	6633, 6595, 6675, 4407, 4511, 4406, 7194, 7325, 6667, 4729, 6197, 6254, 6320,
+	6433, 6542, 6599, 4497, 4484, 4516, 7197, 7350, 6670, 6522, 4837, 6164, 6214,
+	6324, 6387, 6528, 4566, 4603, 4588, 7258, 7535, 6806, 6671, 6494, 5040, 6138,
+	6135, 6293, 6491, 4460, 4537, 4596, 7193, 7565, 6835, 6665, 6518, 6574, 4998,
+	6016, 6182, 6336, 4521, 4595, 4550, 7174, 7529, 7016, 6866, 6681, 6508, 6408,
+	5042, 6204, 6307, 4497, 4564, 4550, 7270, 7540, 7061, 6960, 6793, 6702, 6598,
+	6577, 5117, 6314, 4523, 4637, 4569, 7363, 7646, 7098, 7003, 6924, 6816, 6656,
+	6676, 6685, 5181, 8263, 8348, 8474, 8612, 8791, 8768, 8769, 8862, 7234, 7178,
+	7166, 7120, 8742, 8170, 8152, 8204, 8189, 8216, 8363, 8435, 8440, 7177, 7200,
+	7197, 7169, 8881, 8617, 8181, 8019, 8000, 8110, 8243, 8396, 8419, 7128, 7164,
+	7219, 7283, 8993, 8588, 8381, 8122, 7874, 7933, 8089, 8228, 8394, 7227, 7159,
+	7241, 7235, 9165, 8632, 8486, 8301, 8110, 7792, 7959, 8075, 8286, 7041, 7204,
+	7212, 7206, 9293, 8645, 8503, 8310, 8256, 8092, 7774, 7947, 8061, 7003, 7194,
+	7227, 7273, 9287, 8780, 8698, 8498, 8327, 8200, 8078, 7904, 8089, 7119, 7290,
+	7238, 7243, 9312, 8815, 8820, 8686, 8540, 8361, 8368, 8114, 8182, 7087, 7275,
+	7306, 7298, 9354, 8808, 8846, 8861, 8686, 8525, 8503, 8558, 8215, 6735, 6701,
+	6719, 6752, 6761, 6783, 6769, 3509, 3353, 3223, 3156, 5253, 3874, 6898, 6952,
+	7001, 7116, 7147, 7110, 7154, 4661, 4715, 4612, 4477, 7063, 7295, 4847, 6408,
+	6495, 6593, 6730, 6871, 6858, 4675, 4741, 4734, 4598, 7126, 7308, 6730, 4959,
+	6372, 6431, 6604, 6665, 6827, 4751, 4829, 4788, 4772, 7117, 7374, 6795, 6671,
+	5119, 6367, 6487, 6499, 6672, 4800, 4866, 4874, 4853, 7163, 7582, 6880, 6694,
+	6697, 5215, 6429, 6386, 6522, 4865, 4884, 4899, 4876, 7134, 7556, 7002, 6821,
+	6712, 6698, 5263, 6417, 6525, 4815, 4876, 4831, 4889, 7165, 7573, 7155, 6999,
+	6829, 6743, 6682, 5334, 6512, 4795, 4880, 4832, 4880, 7245, 7620, 7189, 7111,
+	6985, 6871, 6793, 6866, 5409, 6519, 6445, 6493, 6544, 6523, 6534, 4289, 4195,
+	4117, 4013, 3433, 3291, 6905, 6251, 6303, 6360, 6571, 6521, 6543, 3324, 3364,
+	3227, 3146, 5247, 7288, 3765, 6851, 6848, 6986, 6938, 7130, 7180, 4554, 4618,
+	4670, 4526, 6849, 6566, 7188, 4887, 6294, 6450, 6536, 6680, 6728, 4720, 4672,
+	4619, 4648, 6855, 6627, 7238, 6597, 4971, 6292, 6366, 6525, 6559, 4724, 4664,
+	4690, 4753, 6895, 6714, 7392, 6680, 6587, 5108, 6263, 6431, 6482, 4652, 4750,
+	4743, 4732, 6906, 6797, 7375, 6821, 6684, 6479, 5098, 6394, 6453, 4731, 4736,
+	4734, 4767, 6983, 6864, 7579, 6972, 6814, 6656, 6685, 5222, 6450, 4692, 4763,
+	4781, 4798, 6979, 6853, 7570, 7110, 6905, 6772, 6736, 6838, 5317, 6210, 6195,
+	6220, 6236, 6280, 4209, 4058, 4068, 3888, 3444, 3223, 5698, 6871, 6226, 6331,
+	6467, 6384, 6490, 4142, 4215, 4125, 4009, 3660, 6015, 3301, 6745, 6074, 6209,
+	6308, 6487, 6436, 3184, 3205, 3250, 3179, 5271, 7275, 7161, 3775, 6686, 6873,
+	6881, 7020, 7131, 4551, 4556, 4512, 4530, 6534, 6522, 6374, 7049, 4881, 6219,
+	6371, 6532, 6590, 4569, 4605, 4568, 4565, 6556, 6578, 6488, 7180, 6560, 4968,
+	6194, 6334, 6438, 4589, 4609, 4599, 4546, 6618, 6711, 6662, 7237, 6612, 6486,
+	5089, 6316, 6453, 4661, 4651, 4631, 4597, 6603, 6678, 6788, 7356, 6787, 6605,
+	6575, 5070, 6473, 4652, 4704, 4655, 4684, 6633, 6836, 6738, 7586, 6928, 6776,
+	6802, 6766, 5196, 5867, 5869, 5873, 5926, 4119, 4006, 3909, 3834, 3379, 3217,
+	5610, 5682, 6851, 6274, 6386, 6370, 6337, 4113, 4111, 4083, 3902, 3635, 5864,
+	3268, 5639, 6743, 6110, 6278, 6414, 6315, 4091, 4093, 4157, 4022, 4003, 6013,
+	5910, 3314, 6628, 6012, 6107, 6226, 6432, 3075, 3085, 3090, 3161, 5252, 7276,
+	7136, 7017, 3680, 6696, 6706, 6840, 6998, 4476, 4493, 4468, 4448, 6206, 6533,
+	6391, 6299, 7093, 4909, 6151, 6297, 6388, 4467, 4511, 4458, 4517, 6218, 6662,
+	6558, 6404, 7078, 6453, 4935, 6317, 6435, 4540, 4512, 4525, 4549, 6251, 6641,
+	6733, 6566, 7293, 6591, 6589, 5042, 6431, 4610, 4554, 4547, 4538, 6240, 6648,
+	6610, 6713, 7458, 6760, 6759, 6755, 5118, 5494, 5506, 5567, 4085, 3963, 3896,
+	3738, 3370, 3175, 5468, 5525, 5668, 6901, 6244, 6256, 6330, 4006, 4055, 3912,
+	3805, 3640, 5690, 3210, 5553, 5626, 6791, 6177, 6260, 6270, 4013, 4013, 4001,
+	3992, 3987, 5854, 5701, 3355, 5541, 6627, 6057, 6206, 6300, 4022, 3985, 4026,
+	3975, 4332, 5962, 5839, 5833, 3392, 6487, 5966, 6088, 6155, 2953, 2972, 3020,
+	3015, 5237, 7248, 7156, 7024, 6889, 3567, 6582, 6710, 6876, 4280, 4366, 4381,
+	4365, 5802, 6581, 6367, 6322, 6318, 6972, 4760, 6237, 6350, 4332, 4397, 4412,
+	4321, 5803, 6578, 6596, 6461, 6410, 7113, 6525, 4894, 6431, 4331, 4427, 4402,
+	4481, 5841, 6560, 6581, 6620, 6518, 7274, 6669, 6677, 4969, 5297, 5285, 3992,
+	3830, 3866, 3716, 3354, 3158, 5334, 5506, 5686, 5810, 7051, 6280, 6273, 3949,
+	4045, 3948, 3831, 3695, 5670, 3256, 5411, 5593, 5723, 6835, 6235, 6238, 3998,
+	4043, 4053, 3958, 3932, 5805, 5733, 3284, 5541, 5669, 6721, 6213, 6262, 3998,
+	4037, 4017, 3986, 4362, 5900, 5928, 5744, 3367, 5616, 6615, 6077, 6154, 3942,
+	3997, 4045, 4016, 4796, 6074, 5946, 5951, 5916, 3442, 6506, 6008, 6080, 3020,
+	3002, 2994, 3022, 5251, 7410, 7271, 7116, 7025, 6891, 3660, 6689, 6846, 4274,
+	4409, 4417, 4359, 5560, 6499, 6534, 6463, 6402, 6280, 7121, 4849, 6451, 4316,
+	4454, 4446, 4391, 5641, 6550, 6538, 6692, 6485, 6359, 7225, 6669, 4967, 5291,
+	4036, 3900, 3792, 3697, 3368, 3144, 5390, 5494, 5632, 5741, 5884, 6959, 6212,
+	3953, 4037, 3876, 3839, 3576, 5583, 3191, 5402, 5543, 5613, 5776, 6990, 6222,
+	3984, 3990, 3988, 3934, 3937, 5801, 5677, 3294, 5505, 5560, 5681, 6912, 6276,
+	4082, 3995, 3933, 3968, 4336, 5911, 5835, 5718, 3381, 5511, 5672, 6720, 6136,
+	3947, 4039, 3994, 4025, 4712, 6066, 5966, 5826, 5882, 3378, 5573, 6581, 6042,
+	3946, 3986, 4044, 4035, 4945, 6135, 6081, 6015, 5875, 5864, 3452, 6630, 6065,
+	2933, 3033, 2952, 3003, 5258, 7380, 7401, 7244, 7118, 7000, 6967, 3713, 6882,
+	4322, 4463, 4381, 4396, 5565, 6612, 6484, 6531, 6507, 6338, 6370, 7261, 4953,
+	3978, 3918, 3780, 3686, 3339, 3142, 5389, 5491, 5655, 5769, 5877, 5886, 7053,
+	3971, 3939, 3942, 3764, 3599, 5671, 3187, 5391, 5514, 5605, 5723, 5917, 6967,
+	3971, 3958, 3942, 3883, 3860, 5717, 5639, 3189, 5318, 5488, 5631, 5762, 6925,
+	4040, 3997, 3960, 3991, 4286, 5941, 5767, 5627, 3319, 5456, 5556, 5688, 6856,
+	3962, 4036, 3998, 3993, 4746, 6031, 5902, 5798, 5773, 3391, 5491, 5628, 6685,
+	3964, 4027, 3993, 3960, 4937, 6139, 6058, 5900, 5825, 5731, 3468, 5628, 6736,
+	3942, 4042, 3973, 3991, 4956, 6109, 6186, 6010, 5961, 5861, 5879, 3519, 6781,
+	2902, 3009, 2972, 2996, 5253, 7479, 7414, 7386, 7225, 7197, 7134, 7173, 3718,
+	7438, 7276, 7093, 6937, 2980, 6687, 6704, 6906, 7020, 7177, 7168, 7308, 6986,
+	6432, 6252, 6159, 6030, 7017, 4312, 5915, 5998, 6073, 6256, 6403, 6385, 6938,
+	6429, 6426, 6250, 6139, 7161, 6262, 4405, 5817, 5985, 6131, 6269, 6414, 6918,
+	6325, 6324, 6308, 6239, 7274, 6309, 6242, 4372, 5874, 6010, 6126, 6230, 6723,
+	6288, 6356, 6297, 6354, 7472, 6510, 6338, 6257, 4437, 5869, 6018, 6158, 6789,
+	6393, 6331, 6367, 6421, 7606, 6708, 6532, 6367, 6294, 4605, 6073, 6225, 6798,
+	6397, 6357, 6340, 6325, 7644, 6815, 6671, 6448, 6387, 6436, 4684, 6149, 6835,
+	6403, 6408, 6473, 6449, 7684, 6828, 6750, 6665, 6491, 6563, 6581, 4679, 7370,
+	7215, 6969, 3144, 6698, 6745, 6849, 6952, 7116, 7101, 7196, 4081, 7012, 6440,
+	6349, 6152, 7112, 4401, 5947, 6012, 6121, 6215, 6385, 6378, 4097, 6927, 6519,
+	6356, 6229, 7178, 6294, 4402, 5888, 6004, 6124, 6204, 6381, 4103, 6955, 6407,
+	6420, 6320, 7240, 6405, 6280, 4466, 5904, 6049, 6169, 6306, 4086, 6923, 6381,
+	6402, 6400, 7403, 6527, 6375, 6329, 4576, 5882, 5990, 6172, 4133, 6944, 6489,
+	6453, 6466, 7539, 6695, 6531, 6384, 6271, 4682, 6115, 6210, 4160, 6936, 6476,
+	6468, 6428, 7554, 6816, 6675, 6551, 6384, 6409, 4772, 6215, 4106, 6997, 6470,
+	6456, 6527, 7590, 6756, 6883, 6660, 6525, 6535, 6548, 4826, 7361, 7150, 3240,
+	6708, 6854, 6841, 6940, 7104, 7091, 7160, 4156, 4175, 6987, 6390, 6251, 7132,
+	4514, 6029, 6093, 6211, 6374, 6385, 6458, 4237, 4220, 6964, 6468, 6359, 7328,
+	6362, 4613, 5984, 6056, 6201, 6321, 6452, 4202, 4282, 6953, 6604, 6516, 7225,
+	6480, 6316, 4626, 5904, 6064, 6219, 6316, 4200, 4296, 6936, 6502, 6499, 7385,
+	6580, 6400, 6273, 4767, 5993, 6055, 6205, 4258, 4301, 6987, 6530, 6555, 7536,
+	6756, 6556, 6445, 6339, 4843, 6095, 6265, 4265, 4275, 7004, 6544, 6544, 7582,
+	6859, 6674, 6575, 6433, 6494, 4881, 6230, 4296, 4293, 7110, 6591, 6669, 7633,
+	6895, 6865, 6750, 6517, 6601, 6575, 4965, 7275, 3384, 6733, 6763, 6933, 6903,
+	7101, 7088, 7147, 4298, 4195, 4245, 6914, 6378, 7153, 4569, 6082, 6193, 6299,
+	6410, 6544, 6568, 4310, 4275, 4314, 7030, 6438, 7222, 6507, 4695, 6062, 6112,
+	6256, 6380, 6524, 4408, 4446, 4366, 7021, 6542, 7390, 6586, 6397, 4776, 5999,
+	6184, 6195, 6359, 4374, 4373, 4479, 6977, 6640, 7340, 6616, 6463, 6371, 4801,
+	5997, 6073, 6263, 4399, 4406, 4494, 7012, 6679, 7522, 6782, 6626, 6520, 6369,
+	4989, 6148, 6259, 4373, 4514, 4414, 7079, 6631, 7540, 6987, 6748, 6598, 6474,
+	6476, 4961, 6253, 4464, 4399, 4504, 7125, 6680, 7564, 6987, 6958, 6770, 6602,
+	6683, 6674, 5048, 3527, 6810, 6895, 6905, 6984, 7020, 7093, 7073, 4352, 4439,
+	4270, 4206, 6939, 7244, 4651, 6255, 6295, 6399, 6484, 6620, 6639, 4404, 4409,
+	4490, 4332, 6990, 7303, 6567, 4700, 6140, 6263, 6284, 6437, 6577, 4504, 4454,
+	4467, 4505, 7005, 7287, 6673, 6473, 4825, 6133, 6169, 6330, 6382, 4540, 4568,
+	4547, 4560, 6995, 7464, 6729, 6593, 6473, 5033, 6169, 6222, 6279, 4537, 4600,
+	4569, 4548, 7033, 7440, 6892, 6707, 6586, 6535, 5010, 6152, 6304, 4520, 4578,
+	4563, 4529, 7008, 7500, 7034, 6853, 6622, 6520, 6513, 5056, 6278, 4560, 4578,
+	4587, 4532, 7072, 7547, 7038, 7032, 6836, 6654, 6681, 6656, 5118, 8216, 8368,
+	8481, 8599, 8791, 8788, 8830, 7155, 7134, 7144, 7065, 7045, 8692, 8227, 8166,
+	8183, 8179, 8267, 8449, 8408, 7095, 7150, 7200, 7115, 7066, 8822, 8572, 8150,
+	8003, 8070, 8111, 8290, 8438, 7136, 7126, 7135, 7172, 7117, 8986, 8559, 8384,
+	8131, 7862, 8000, 8091, 8284, 6952, 7111, 7068, 7152, 7219, 9098, 8594, 8428,
+	8264, 8075, 7848, 7929, 8128, 7045, 7156, 7171, 7145, 7200, 9307, 8673, 8507,
+	8354, 8185, 8102, 8005, 8122, 6980, 7185, 7179, 7202, 7196, 9331, 8866, 8726,
+	8558, 8382, 8393, 8121, 8165, 7083, 7155, 7249, 7201, 7261, 9336, 8833, 8853,
+	8698, 8510, 8564, 8592, 8184, 6449, 6470, 6492, 6607, 6565, 6588, 3400, 3478,
+	3389, 3328, 3225, 5244, 3892, 6806, 6848, 6913, 7042, 7040, 7082, 4709, 4615,
+	4727, 4652, 4564, 6847, 7161, 4877, 6448, 6497, 6626, 6693, 6828, 4726, 4705,
+	4748, 4697, 4679, 6858, 7240, 6713, 5023, 6316, 6472, 6641, 6685, 4736, 4710,
+	4778, 4781, 4855, 6833, 7218, 6792, 6592, 5132, 6278, 6371, 6517, 4766, 4866,
+	4894, 4972, 4916, 6980, 7475, 6962, 6693, 6609, 5292, 6497, 6519, 4825, 4784,
+	4910, 4848, 4870, 6953, 7438, 6980, 6851, 6735, 6818, 5321, 6589, 4876, 4846,
+	4892, 4918, 4873, 6993, 7493, 7127, 6978, 6784, 6826, 6857, 5296, 6160, 6236,
+	6235, 6251, 6376, 4271, 4287, 4189, 4106, 3952, 3664, 3300, 6764, 6218, 6239,
+	6410, 6522, 6546, 3295, 3335, 3348, 3286, 3208, 5248, 7086, 3827, 6712, 6757,
+	6940, 6815, 7095, 4637, 4549, 4632, 4634, 4595, 6544, 6481, 7040, 4891, 6281,
+	6402, 6520, 6628, 4654, 4672, 4613, 4665, 4653, 6560, 6576, 7139, 6549, 4973,
+	6222, 6331, 6511, 4647, 4746, 4757, 4710, 4783, 6636, 6720, 7277, 6744, 6581,
+	5159, 6345, 6545, 4710, 4803, 4722, 4729, 4761, 6638, 6836, 7298, 6836, 6625,
+	6576, 5246, 6510, 4727, 4819, 4726, 4752, 4740, 6635, 6778, 7532, 6993, 6765,
+	6810, 6765, 5278, 5869, 5929, 5956, 5932, 4215, 4278, 4104, 4006, 3907, 3630,
+	3228, 5652, 6772, 6187, 6348, 6409, 6367, 4136, 4167, 4241, 4087, 4061, 3981,
+	5932, 3334, 6632, 6077, 6223, 6332, 6452, 3216, 3215, 3252, 3281, 3251, 5253,
+	7175, 6964, 3777, 6573, 6757, 6795, 6955, 4483, 4508, 4525, 4586, 4547, 6147,
+	6449, 6332, 6948, 4878, 6259, 6297, 6435, 4570, 4676, 4628, 4557, 4670, 6245,
+	6624, 6510, 7134, 6462, 5034, 6271, 6455, 4655, 4665, 4658, 4588, 4606, 6273,
+	6673, 6588, 7182, 6554, 6553, 5028, 6365, 4626, 4671, 4653, 4631, 4636, 6304,
+	6698, 6754, 7311, 6776, 6735, 6713, 5189, 5622, 5545, 5663, 4086, 4068, 4030,
+	3941, 3800, 3647, 3211, 5583, 5650, 6729, 6235, 6315, 6322, 4074, 4096, 4130,
+	4004, 3963, 3960, 5774, 3320, 5572, 6638, 6105, 6232, 6377, 4074, 4113, 4108,
+	4087, 3980, 4369, 5899, 5817, 3363, 6487, 5997, 6166, 6284, 3011, 3092, 3151,
+	3187, 3207, 5254, 7155, 6991, 6868, 3646, 6578, 6556, 6762, 4424, 4500, 4487,
+	4471, 4493, 5877, 6505, 6380, 6283, 7018, 4948, 6308, 6387, 4400, 4524, 4539,
+	4456, 4419, 5899, 6686, 6491, 6467, 6927, 6534, 4925, 6455, 4370, 4591, 4575,
+	4531, 4525, 5886, 6617, 6622, 6542, 7126, 6731, 6729, 5028, 5296, 5313, 3893,
+	4022, 3905, 3838, 3696, 3574, 3160, 5385, 5548, 5665, 6768, 6183, 6213, 3914,
+	4039, 3984, 3865, 3814, 3941, 5610, 3176, 5468, 5618, 6606, 6156, 6237, 3913,
+	4027, 3956, 3961, 3874, 4280, 5819, 5724, 3307, 5542, 6459, 6031, 6114, 3905,
+	3950, 3974, 3962, 3931, 4608, 5932, 5846, 5800, 3346, 6365, 5868, 6020, 2915,
+	2992, 3001, 3040, 3045, 5264, 7152, 7020, 6911, 6737, 3601, 6592, 6727, 4304,
+	4408, 4383, 4363, 4392, 5542, 6510, 6451, 6287, 6185, 6931, 4854, 6329, 4311,
+	4404, 4389, 4421, 4428, 5596, 6451, 6578, 6382, 6294, 7111, 6599, 4896, 5284,
+	3995, 3997, 3861, 3803, 3685, 3572, 3178, 5372, 5513, 5591, 5718, 6873, 6208,
+	4001, 3990, 4016, 3865, 3789, 3911, 5600, 3222, 5440, 5591, 5685, 6766, 6303,
+	3982, 3980, 4030, 4001, 3923, 4270, 5728, 5601, 3339, 5467, 5618, 6629, 6138,
+	3863, 4034, 3990, 3970, 4007, 4657, 5856, 5790, 5709, 3338, 5510, 6541, 6032,
+	3990, 4087, 4051, 4057, 4030, 4969, 6056, 6018, 5869, 5760, 3450, 6531, 6042,
+	2922, 3058, 3015, 3075, 3122, 5251, 7307, 7187, 7047, 6895, 6928, 3658, 6741,
+	4315, 4341, 4478, 4411, 4395, 5575, 6465, 6506, 6434, 6283, 6386, 7129, 4873,
+	3920, 4006, 3862, 3808, 3723, 3605, 3143, 5299, 5483, 5609, 5731, 5844, 6907,
+	3974, 3955, 3965, 3859, 3772, 3842, 5565, 3142, 5351, 5418, 5586, 5731, 6914,
+	3986, 4117, 3914, 3992, 3917, 4224, 5694, 5597, 3257, 5344, 5476, 5680, 6742,
+	3913, 3977, 3988, 3988, 3996, 4611, 5873, 5695, 5645, 3310, 5446, 5589, 6607,
+	3899, 4071, 4041, 4027, 4005, 4996, 6067, 5890, 5787, 5707, 3423, 5593, 6709,
+	3960, 4012, 4015, 4010, 4058, 4988, 6167, 5964, 5976, 5884, 5929, 3535, 6642,
+	2912, 2979, 2965, 3017, 3090, 5252, 7276, 7314, 7214, 6986, 6983, 7089, 3635,
+	7528, 7398, 7182, 7113, 6985, 3034, 6595, 6744, 6894, 7055, 7216, 7270, 6681,
+	6394, 6459, 6306, 6189, 6090, 6935, 4315, 5912, 6037, 6210, 6272, 6420, 6667,
+	6353, 6388, 6403, 6206, 6182, 7141, 6275, 4405, 5840, 6028, 6151, 6345, 6483,
+	6359, 6326, 6371, 6367, 6326, 7335, 6390, 6294, 4448, 5802, 6031, 6179, 6505,
+	6329, 6435, 6286, 6369, 6362, 7463, 6492, 6346, 6207, 4548, 6073, 6221, 6541,
+	6417, 6373, 6429, 6374, 6424, 7673, 6676, 6577, 6449, 6391, 4703, 6281, 6578,
+	6402, 6433, 6424, 6374, 6462, 7685, 6827, 6677, 6571, 6591, 6634, 4698, 7336,
+	7197, 7014, 6955, 2966, 6622, 6706, 6851, 7014, 7133, 7164, 3969, 6680, 6431,
+	6311, 6181, 6027, 6945, 4298, 5877, 5984, 6095, 6275, 6389, 3992, 6645, 6328,
+	6399, 6242, 6163, 7125, 6279, 4400, 5860, 5997, 6169, 6262, 3972, 6600, 6293,
+	6314, 6378, 6192, 7237, 6356, 6211, 4452, 5853, 6000, 6170, 4017, 6625, 6292,
+	6274, 6276, 6334, 7407, 6493, 6338, 6246, 4562, 6069, 6183, 4048, 6723, 6327,
+	6404, 6377, 6377, 7585, 6675, 6485, 6349, 6378, 4651, 6171, 4008, 6671, 6357,
+	6392, 6364, 6400, 7613, 6818, 6713, 6547, 6592, 6572, 4760, 7315, 7179, 7010,
+	3136, 6674, 6684, 6805, 6973, 7156, 7113, 4140, 4073, 6735, 6412, 6288, 6169,
+	7136, 4464, 5928, 6046, 6181, 6331, 6430, 4131, 4091, 6679, 6494, 6334, 6246,
+	7099, 6304, 4452, 5910, 6005, 6221, 6281, 4097, 4148, 6677, 6484, 6509, 6356,
+	7230, 6448, 6175, 4555, 5869, 6031, 6225, 4141, 4136, 6655, 6445, 6461, 6472,
+	7380, 6525, 6346, 6296, 4635, 6084, 6160, 4204, 4206, 6738, 6510, 6458, 6514,
+	7551, 6720, 6551, 6345, 6438, 4747, 6254, 4234, 4167, 6781, 6502, 6497, 6491,
+	7571, 6892, 6705, 6618, 6552, 6627, 4836, 7179, 7086, 3282, 6659, 6801, 6752,
+	6951, 7110, 7122, 4200, 4197, 4217, 6762, 6386, 6294, 7030, 4550, 6038, 6044,
+	6207, 6354, 6551, 4261, 4263, 4259, 6710, 6460, 6370, 7201, 6358, 4625, 5884,
+	6139, 6179, 6316, 4239, 4311, 4216, 6729, 6508, 6429, 7172, 6493, 6373, 4654,
+	5955, 6113, 6208, 4283, 4291, 4316, 6749, 6497, 6537, 7386, 6618, 6398, 6216,
+	4807, 6122, 6209, 4272, 4337, 4296, 6776, 6614, 6584, 7561, 6740, 6592, 6428,
+	6513, 4868, 6290, 4296, 4318, 4294, 6838, 6597, 6634, 7551, 6905, 6728, 6590,
+	6497, 6652, 4939, 7226, 3433, 6682, 6698, 6861, 6855, 7026, 7033, 4272, 4293,
+	4245, 4202, 6713, 6378, 7056, 4564, 6096, 6174, 6288, 6418, 6589, 4344, 4303,
+	4368, 4382, 6720, 6450, 7118, 6424, 4690, 6049, 6141, 6299, 6402, 4369, 4436,
+	4360, 4411, 6720, 6521, 7294, 6506, 6448, 4852, 6021, 6137, 6237, 4358, 4378,
+	4403, 4460, 6736, 6665, 7300, 6649, 6510, 6391, 4953, 6144, 6191, 4406, 4455,
+	4432, 4461, 6761, 6683, 7489, 6776, 6649, 6504, 6509, 5050, 6261, 4428, 4472,
+	4444, 4463, 6812, 6695, 7519, 6944, 6794, 6631, 6603, 6641, 5056, 3553, 6687,
+	6680, 6724, 6969, 6957, 6934, 4358, 4336, 4357, 4282, 4196, 6704, 7200, 4599,
+	6206, 6236, 6299, 6516, 6671, 4441, 4435, 4472, 4490, 4380, 6657, 7176, 6546,
+	4758, 6077, 6230, 6309, 6448, 4417, 4451, 4440, 4507, 4541, 6715, 7156, 6600,
+	6508, 4910, 6062, 6200, 6263, 4554, 4469, 4628, 4571, 4566, 6814, 7385, 6765,
+	6629, 6412, 5013, 6226, 6235, 4503, 4541, 4555, 4547, 4610, 6798, 7363, 6847,
+	6632, 6529, 6631, 5063, 6311, 4523, 4508, 4534, 4588, 4582, 6815, 7408, 6976,
+	6782, 6598, 6651, 6695, 5112, 8216, 8336, 8481, 8605, 8793, 8795, 7132, 7118,
+	7116, 7095, 6970, 7010, 8680, 8216, 8142, 8224, 8218, 8275, 8441, 7118, 7061,
+	7081, 7182, 7076, 7057, 8808, 8522, 8130, 7996, 8035, 8103, 8314, 6961, 7047,
+	7024, 7119, 7106, 7145, 8958, 8610, 8441, 8090, 7845, 7984, 8126, 6944, 7118,
+	7098, 7129, 7160, 7216, 9139, 8622, 8470, 8278, 8157, 7966, 8160, 7036, 7121,
+	7112, 7189, 7175, 7248, 9340, 8692, 8541, 8366, 8387, 8185, 8200, 7023, 7098,
+	7082, 7186, 7218, 7237, 9341, 8867, 8717, 8509, 8514, 8599, 8128, 6139, 6216,
+	6270, 6305, 6297, 3450, 3502, 3490, 3418, 3324, 3357, 5257, 3945, 6669, 6619,
+	6741, 6907, 6915, 4694, 4668, 4672, 4737, 4623, 4678, 6506, 7005, 4888, 6363,
+	6441, 6522, 6618, 4700, 4656, 4733, 4772, 4755, 4745, 6541, 7049, 6643, 5027,
+	6272, 6368, 6471, 4798, 4810, 4772, 4782, 4806, 4892, 6614, 7148, 6704, 6520,
+	5092, 6427, 6474, 4874, 4916, 4792, 4886, 4855, 5004, 6694, 7332, 6867, 6643,
+	6735, 5316, 6646, 4843, 4875, 4882, 4844, 4887, 4989, 6635, 7358, 7002, 6773,
+	6755, 6858, 5373, 5824, 5950, 5973, 5981, 4285, 4273, 4330, 4239, 4110, 4043,
+	3971, 3308, 6598, 6158, 6232, 6387, 6502, 3262, 3338, 3379, 3452, 3377, 3301,
+	5234, 6965, 3884, 6526, 6562, 6787, 6789, 4627, 4586, 4603, 4576, 4631, 4600,
+	6153, 6357, 6873, 4904, 6189, 6277, 6498, 4674, 4726, 4731, 4698, 4709, 4809,
+	6287, 6543, 6988, 6492, 4954, 6313, 6393, 4708, 4712, 4778, 4700, 4749, 4815,
+	6256, 6636, 7160, 6559, 6655, 5178, 6420, 4736, 4772, 4835, 4776, 4799, 4851,
+	6311, 6745, 7191, 6765, 6663, 6746, 5233, 5529, 5618, 5684, 4227, 4222, 4259,
+	4128, 4036, 3959, 3998, 3339, 5548, 6581, 6199, 6206, 6376, 4264, 4143, 4180,
+	4257, 4144, 4085, 4332, 5880, 3358, 6464, 6136, 6124, 6276, 3189, 3203, 3266,
+	3278, 3407, 3376, 5247, 6969, 6850, 3837, 6392, 6575, 6592, 4449, 4523, 4596,
+	4526, 4522, 4649, 5859, 6446, 6297, 6769, 4906, 6304, 6348, 4581, 4628, 4620,
+	4659, 4611, 4649, 5899, 6569, 6407, 6953, 6557, 5034, 6414, 4560, 4584, 4639,
+	4606, 4685, 4688, 5985, 6658, 6533, 6993, 6706, 6627, 5082, 5356, 5347, 4086,
+	4098, 4117, 4032, 3930, 3894, 3913, 3241, 5460, 5568, 6616, 6159, 6261, 4057,
+	4121, 4101, 4115, 4015, 3921, 4279, 5729, 3323, 5486, 6473, 6022, 6175, 3968,
+	4063, 4082, 4046, 4093, 4001, 4673, 5823, 5782, 3382, 6334, 5930, 6001, 3024,
+	3102, 3176, 3200, 3238, 3359, 5275, 6955, 6832, 6686, 3722, 6545, 6603, 4383,
+	4521, 4536, 4533, 4481, 4535, 5638, 6407, 6345, 6177, 6985, 4932, 6276, 4341,
+	4515, 4562, 4509, 4486, 4553, 5640, 6509, 6490, 6293, 6963, 6662, 5014, 5296,
+	3961, 3876, 4015, 3898, 3808, 3739, 3867, 3114, 5317, 5434, 5645, 6590, 6139,
+	3980, 3896, 3950, 3956, 3929, 3853, 4183, 5573, 3259, 5351, 5512, 6507, 6015,
+	3898, 3962, 3939, 3921, 3959, 3840, 4549, 5770, 5607, 3267, 5425, 6319, 5896,
+	3863, 4025, 3980, 3953, 3984, 4052, 4913, 5836, 5787, 5753, 3388, 6424, 5883,
+	2946, 2920, 2988, 3055, 3108, 3245, 5251, 6987, 6914, 6735, 6739, 3657, 6595,
+	4294, 4379, 4383, 4421, 4442, 4416, 5524, 6456, 6278, 6188, 6195, 6987, 4889,
+	3941, 3945, 3952, 3869, 3790, 3716, 3876, 3074, 5238, 5435, 5515, 5644, 6808,
+	3919, 4011, 3956, 4007, 3923, 3792, 4233, 5472, 3171, 5298, 5462, 5627, 6609,
+	3863, 3908, 4006, 3942, 3971, 3957, 4594, 5693, 5568, 3260, 5360, 5554, 6555,
+	3831, 4048, 4003, 3979, 3990, 4062, 4930, 5812, 5709, 5620, 3364, 5592, 6457,
+	3964, 4094, 4101, 4005, 4060, 4071, 4968, 6011, 5899, 5789, 5740, 3456, 6513,
+	2964, 3004, 3051, 3048, 3105, 3239, 5257, 7159, 7044, 6848, 6902, 6925, 3628,
+	7514, 7527, 7385, 7218, 7046, 6877, 3074, 6606, 6792, 6923, 7098, 7240, 6280,
+	6335, 6319, 6396, 6264, 6198, 6052, 7044, 4311, 5907, 6034, 6170, 6323, 6156,
+	6404, 6360, 6322, 6380, 6272, 6192, 7216, 6244, 4479, 5868, 6032, 6216, 6129,
+	6369, 6366, 6365, 6328, 6427, 6293, 7394, 6411, 6268, 4538, 6063, 6209, 6234,
+	6406, 6374, 6370, 6404, 6400, 6430, 7587, 6582, 6395, 6424, 4628, 6226, 6244,
+	6494, 6436, 6401, 6420, 6402, 6505, 7710, 6663, 6582, 6645, 6666, 4772, 7506,
+	7370, 7235, 7073, 6892, 3036, 6585, 6716, 6893, 7046, 7147, 4010, 6313, 6373,
+	6413, 6276, 6156, 6115, 6989, 4345, 5847, 5982, 6121, 6311, 3989, 6329, 6303,
+	6309, 6361, 6247, 6145, 7082, 6226, 4517, 5866, 6024, 6141, 3983, 6303, 6331,
+	6392, 6335, 6353, 6324, 7237, 6368, 6236, 4509, 6036, 6217, 4050, 6285, 6337,
+	6336, 6342, 6360, 6457, 7412, 6555, 6348, 6397, 4649, 6222, 4109, 6402, 6426,
+	6456, 6403, 6494, 6458, 7615, 6628, 6569, 6573, 6630, 4756, 7295, 7146, 6969,
+	6933, 3066, 6592, 6666, 6823, 6956, 7143, 4028, 3973, 6254, 6382, 6255, 6192,
+	6023, 6944, 4367, 5902, 6035, 6117, 6285, 3956, 4048, 6324, 6305, 6349, 6334,
+	6070, 7051, 6277, 4474, 5846, 6002, 6145, 3966, 3992, 6278, 6272, 6407, 6356,
+	6234, 7211, 6372, 6184, 4579, 6033, 6139, 4032, 4047, 6362, 6327, 6318, 6329,
+	6400, 7435, 6525, 6401, 6440, 4667, 6191, 4077, 4169, 6349, 6397, 6349, 6432,
+	6426, 7566, 6664, 6536, 6538, 6589, 4734, 7243, 7144, 6946, 3131, 6682, 6646,
+	6823, 6907, 7049, 4112, 4139, 4044, 6344, 6363, 6335, 6124, 7043, 4542, 5961,
+	6066, 6219, 6313, 4104, 4105, 4076, 6331, 6487, 6371, 6186, 7026, 6249, 4600,
+	5889, 6011, 6228, 4168, 4157, 4164, 6393, 6416, 6463, 6438, 7171, 6399, 6279,
+	4670, 6099, 6198, 4151, 4167, 4160, 6329, 6473, 6493, 6494, 7365, 6581, 6410,
+	6457, 4747, 6255, 4262, 4261, 4187, 6415, 6485, 6525, 6499, 7557, 6725, 6535,
+	6557, 6666, 4893, 7070, 7008, 3324, 6538, 6682, 6747, 6866, 7043, 4195, 4216,
+	4227, 4178, 6392, 6375, 6235, 6944, 4571, 6063, 6068, 6235, 6415, 4229, 4250,
+	4251, 4233, 6428, 6456, 6306, 7078, 6382, 4748, 5926, 6039, 6213, 4270, 4292,
+	4317, 4256, 6379, 6591, 6493, 7109, 6469, 6317, 4745, 6049, 6196, 4218, 4315,
+	4285, 4334, 6422, 6534, 6574, 7321, 6553, 6387, 6441, 4846, 6291, 4289, 4372,
+	4326, 4375, 6475, 6584, 6643, 7503, 6845, 6606, 6594, 6687, 5007, 7016, 3378,
+	6526, 6600, 6790, 6818, 6933, 4273, 4341, 4326, 4282, 4200, 6400, 6299, 6961,
+	4606, 6034, 6139, 6278, 6417, 4283, 4365, 4362, 4339, 4355, 6407, 6385, 7009,
+	6364, 4737, 5957, 6083, 6246, 4363, 4439, 4396, 4450, 4431, 6448, 6533, 7237,
+	6484, 6412, 4906, 6132, 6320, 4385, 4431, 4409, 4422, 4436, 6464, 6601, 7187,
+	6681, 6531, 6440, 4980, 6280, 4446, 4484, 4512, 4414, 4505, 6502, 6681, 7400,
+	6804, 6631, 6657, 6630, 5013, 3609, 6562, 6539, 6586, 6768, 6815, 4444, 4322,
+	4330, 4342, 4343, 4292, 6304, 6995, 4695, 6094, 6168, 6320, 6460, 4365, 4468,
+	4390, 4377, 4477, 4467, 6366, 6974, 6421, 4750, 5996, 6097, 6265, 4485, 4454,
+	4516, 4447, 4506, 4595, 6392, 7049, 6582, 6378, 4927, 6183, 6216, 4533, 4542,
+	4525, 4633, 4596, 4606, 6533, 7229, 6746, 6512, 6534, 5049, 6363, 4542, 4601,
+	4614, 4555, 4579, 4679, 6506, 7251, 6842, 6576, 6608, 6728, 5135, 8203, 8263,
+	8479, 8681, 8822, 7087, 7093, 7002, 7013, 7027, 7042, 6977, 8666, 8126, 8114,
+	8189, 8254, 8272, 6898, 7050, 6955, 7006, 7069, 6994, 7031, 8815, 8533, 8092,
+	7992, 8039, 8130, 6913, 7122, 6978, 7066, 7041, 7182, 7116, 9001, 8601, 8359,
+	8117, 8072, 8110, 6978, 7064, 7051, 7085, 7116, 7115, 7256, 9190, 8669, 8401,
+	8446, 8132, 8224, 7020, 7170, 7147, 7071, 7131, 7176, 7247, 9349, 8730, 8551,
+	8534, 8599, 8186, 5826, 5896, 5916, 6003, 3423, 3492, 3525, 3552, 3490, 3461,
+	3516, 5246, 3994, 6444, 6516, 6565, 6740, 4641, 4733, 4705, 4641, 4673, 4672,
+	4694, 6136, 6817, 4887, 6205, 6283, 6470, 4761, 4727, 4722, 4716, 4753, 4808,
+	4868, 6232, 6851, 6491, 5048, 6264, 6382, 4805, 4877, 4781, 4786, 4817, 4849,
+	4955, 6282, 6937, 6585, 6564, 5190, 6394, 4872, 4881, 4909, 4865, 4927, 4993,
+	5073, 6382, 7139, 6700, 6696, 6763, 5350, 5528, 5608, 5673, 4282, 4332, 4297,
+	4304, 4206, 4153, 4144, 4369, 3375, 6322, 5992, 6117, 6292, 3322, 3383, 3352,
+	3449, 3516, 3578, 3488, 5270, 6710, 3864, 6286, 6425, 6496, 4481, 4644, 4561,
+	4620, 4611, 4718, 4695, 5845, 6300, 6641, 4964, 6222, 6310, 4636, 4733, 4666,
+	4672, 4668, 4720, 4846, 5943, 6389, 6769, 6530, 5079, 6380, 4708, 4846, 4734,
+	4788, 4781, 4791, 4868, 6011, 6562, 6941, 6642, 6595, 5218, 5358, 5429, 4145,
+	4179, 4169, 4196, 4094, 4060, 4004, 4295, 3328, 5477, 6402, 6025, 6136, 4091,
+	4199, 4131, 4192, 4180, 4129, 4079, 4658, 5641, 3385, 6212, 5892, 5989, 3185,
+	3295, 3302, 3319, 3418, 3503, 3523, 5251, 6741, 6644, 3831, 6374, 6503, 4434,
+	4509, 4551, 4506, 4551, 4621, 4684, 5641, 6284, 6139, 6721, 4924, 6285, 4559,
+	4653, 4660, 4622, 4636, 4655, 4752, 5742, 6430, 6249, 6906, 6587, 5068, 5325,
+	4060, 4025, 4065, 4089, 4026, 3932, 3954, 4257, 3169, 5275, 5416, 6361, 6006,
+	3940, 4101, 4048, 4010, 4095, 3983, 3979, 4590, 5583, 3294, 5391, 6220, 5859,
+	3965, 4033, 4049, 4102, 4055, 4168, 4099, 4945, 5699, 5616, 3393, 6206, 5918,
+	3043, 3118, 3171, 3227, 3220, 3396, 3491, 5235, 6751, 6604, 6632, 3722, 6528,
+	4441, 4582, 4511, 4541, 4505, 4520, 4600, 5610, 6317, 6189, 6171, 6909, 4989,
+	3893, 3951, 3907, 3956, 3850, 3876, 3803, 4130, 3149, 5148, 5344, 5440, 6391,
+	3900, 3901, 3927, 3891, 3964, 3886, 3879, 4523, 5415, 3227, 5196, 5280, 6279,
+	3900, 4014, 3921, 3905, 3973, 4013, 3954, 4762, 5575, 5487, 3302, 5347, 6263,
+	3897, 4026, 3998, 4017, 3979, 4083, 4130, 4904, 5696, 5577, 5575, 3364, 6353,
+	2960, 3034, 3028, 3107, 3156, 3247, 3331, 5263, 6787, 6629, 6640, 6731, 3653,
+	7536, 7466, 7445, 7321, 7198, 7076, 6887, 3055, 6632, 6820, 6946, 7115, 5795,
+	6378, 6384, 6282, 6367, 6321, 6127, 6070, 7042, 4358, 5856, 6038, 6214, 5816,
+	6434, 6349, 6276, 6287, 6419, 6353, 6237, 7211, 6229, 4473, 6085, 6172, 5780,
+	6453, 6428, 6341, 6345, 6366, 6390, 6297, 7400, 6426, 6404, 4594, 6202, 5839,
+	6464, 6399, 6399, 6352, 6405, 6411, 6455, 7562, 6565, 6578, 6583, 4682, 7492,
+	7460, 7315, 7134, 6998, 6924, 3033, 6591, 6720, 6912, 7097, 4030, 5955, 6325,
+	6342, 6324, 6210, 6144, 6056, 6953, 4434, 5910, 6048, 6146, 3976, 5956, 6422,
+	6345, 6297, 6351, 6307, 6237, 7145, 6232, 4499, 6056, 6199, 4019, 6000, 6395,
+	6363, 6386, 6393, 6441, 6310, 7390, 6365, 6440, 4625, 6177, 4102, 5972, 6407,
+	6404, 6413, 6410, 6427, 6427, 7484, 6551, 6599, 6591, 4734, 7448, 7267, 7152,
+	6982, 6824, 3127, 6588, 6690, 6844, 6972, 4003, 3968, 5911, 6342, 6413, 6267,
+	6158, 6095, 6918, 4501, 5885, 6016, 6198, 4061, 4002, 5941, 6388, 6354, 6479,
+	6311, 6205, 7111, 6305, 4615, 6076, 6250, 4076, 4065, 5977, 6409, 6365, 6388,
+	6443, 6336, 7290, 6428, 6436, 4601, 6208, 4086, 4109, 5978, 6392, 6350, 6393,
+	6421, 6476, 7484, 6554, 6571, 6626, 4778, 7220, 7048, 6887, 6789, 3094, 6490,
+	6641, 6858, 6936, 3956, 4019, 3974, 5882, 6365, 6254, 6145, 6011, 6874, 4452,
+	5888, 6072, 6153, 3931, 4057, 4031, 5920, 6404, 6363, 6268, 6162, 7078, 6215,
+	4540, 6011, 6147, 4053, 4045, 4059, 5953, 6274, 6371, 6407, 6293, 7235, 6340,
+	6410, 4644, 6202, 4077, 4122, 4069, 5949, 6381, 6406, 6348, 6411, 7393, 6552,
+	6536, 6538, 4674, 7180, 7025, 6842, 3252, 6569, 6541, 6759, 6892, 4157, 4170,
+	4156, 4158, 5959, 6408, 6244, 6213, 6955, 4572, 5872, 6083, 6218, 4151, 4085,
+	4186, 4144, 6033, 6481, 6398, 6265, 7033, 6253, 4682, 6042, 6192, 4210, 4175,
+	4154, 4195, 6001, 6437, 6458, 6382, 7189, 6437, 6494, 4658, 6219, 4266, 4239,
+	4175, 4229, 6008, 6528, 6465, 6438, 7328, 6560, 6576, 6609, 4839, 7026, 6852,
+	3360, 6495, 6597, 6690, 6789, 4150, 4224, 4257, 4164, 4210, 6005, 6243, 6154,
+	6869, 4647, 5939, 6063, 6208, 4246, 4308, 4290, 4259, 4286, 6111, 6483, 6318,
+	7000, 6320, 4758, 5993, 6168, 4271, 4279, 4312, 4285, 4357, 6099, 6545, 6399,
+	7019, 6397, 6389, 4822, 6240, 4269, 4357, 4312, 4270, 4363, 6062, 6538, 6513,
+	7255, 6601, 6501, 6568, 4896, 6878, 3519, 6386, 6509, 6663, 6653, 4312, 4285,
+	4281, 4280, 4328, 4329, 5944, 6188, 6775, 4643, 5968, 6078, 6204, 4376, 4382,
+	4371, 4314, 4428, 4413, 6065, 6346, 6860, 6327, 4783, 6136, 6210, 4440, 4460,
+	4450, 4448, 4447, 4552, 6109, 6430, 7059, 6481, 6420, 4940, 6207, 4457, 4457,
+	4448, 4391, 4457, 4421, 6123, 6601, 7068, 6546, 6511, 6598, 4959, 3577, 6385,
+	6397, 6421, 6655, 4318, 4387, 4374, 4351, 4440, 4355, 4325, 5960, 6738, 4678,
+	6022, 6054, 6215, 4466, 4482, 4397, 4404, 4420, 4513, 4557, 6082, 6791, 6367,
+	4839, 6058, 6144, 4457, 4513, 4438, 4423, 4526, 4541, 4639, 6089, 6810, 6438,
+	6365, 4908, 6232, 4612, 4625, 4566, 4542, 4616, 4666, 4764, 6171, 7068, 6570,
+	6516, 6528, 5125, 8156, 8318, 8468, 8676, 6899, 6987, 6963, 6906, 6996, 6980,
+	7006, 6998, 8625, 8163, 8107, 8153, 8219, 6939, 7021, 7015, 6974, 6973, 7076,
+	7023, 7078, 8815, 8479, 8100, 8177, 8218, 6954, 7084, 7018, 7032, 6991, 7094,
+	7170, 7117, 8980, 8550, 8550, 8155, 8280, 7006, 7112, 7070, 7043, 6986, 7118,
+	7199, 7257, 9176, 8576, 8642, 8646, 8122, 5546, 5635, 5686, 3495, 3473, 3537,
+	3521, 3535, 3615, 3694, 3716, 5249, 4017, 6165, 6215, 6279, 4584, 4670, 4725,
+	4648, 4633, 4800, 4756, 4817, 5846, 6457, 4987, 6178, 6269, 4668, 4778, 4753,
+	4687, 4730, 4805, 4899, 5073, 5977, 6566, 6430, 5073, 6223, 4744, 4841, 4816,
+	4815, 4779, 4919, 4946, 5167, 6000, 6633, 6529, 6563, 5164, 5370, 5402, 4112,
+	4247, 4309, 4238, 4293, 4193, 4221, 4243, 4689, 3357, 6062, 5797, 5885, 3289,
+	3383, 3369, 3437, 3506, 3656, 3654, 3773, 5253, 6395, 3894, 6107, 6183, 4519,
+	4652, 4629, 4666, 4645, 4674, 4765, 4831, 5618, 6006, 6503, 5001, 6242, 4669,
+	4691, 4746, 4689, 4712, 4754, 4842, 5015, 5745, 6161, 6575, 6417, 5098, 5362,
+	4006, 4077, 4110, 4127, 4150, 4067, 4132, 4094, 4617, 3275, 5193, 6095, 5789,
+	4070, 4171, 4151, 4214, 4174, 4241, 4199, 4248, 4902, 5403, 3366, 6094, 5798,
+	3205, 3240, 3278, 3347, 3407, 3531, 3707, 3763, 5261, 6417, 6409, 3795, 6248,
+	4448, 4609, 4585, 4550, 4580, 4620, 4724, 4830, 5646, 6012, 6010, 6540, 4961,
+	3953, 4019, 4064, 3989, 4058, 4016, 4012, 4014, 4522, 3230, 5051, 5183, 6051,
+	3989, 4016, 4059, 4066, 4066, 4107, 4105, 4146, 4807, 5296, 3315, 5256, 6103,
+	3948, 4098, 4053, 4046, 4045, 4078, 4184, 4223, 4919, 5458, 5514, 3418, 6041,
+	3034, 3118, 3171, 3168, 3260, 3311, 3514, 3736, 5255, 6417, 6451, 6469, 3805,
+	7527, 7539, 7487, 7459, 7423, 7187, 7077, 6887, 3311, 6841, 6927, 7123, 5581,
+	6558, 6488, 6454, 6389, 6508, 6418, 6276, 6262, 7117, 4567, 6228, 6337, 5613,
+	6526, 6521, 6470, 6392, 6431, 6533, 6423, 6337, 7402, 6625, 4628, 6350, 5585,
+	6581, 6536, 6523, 6505, 6516, 6476, 6604, 6464, 7559, 6737, 6742, 4745, 7494,
+	7439, 7427, 7281, 7147, 6963, 6885, 3183, 6631, 6716, 6856, 3848, 5634, 6365,
+	6443, 6365, 6393, 6300, 6220, 6080, 6997, 4480, 5993, 6220, 3874, 5630, 6479,
+	6395, 6341, 6334, 6467, 6306, 6211, 7221, 6427, 4617, 6231, 3964, 5650, 6455,
+	6319, 6368, 6358, 6385, 6445, 6401, 7308, 6578, 6669, 4721, 7444, 7386, 7310,
+	7105, 6932, 6824, 3163, 6532, 6704, 6846, 3933, 3984, 5596, 6404, 6310, 6393,
+	6272, 6214, 6081, 6901, 4485, 6095, 6165, 3906, 4045, 5625, 6449, 6385, 6249,
+	6432, 6349, 6189, 7157, 6441, 4654, 6172, 3994, 4093, 5622, 6405, 6374, 6374,
+	6412, 6474, 6355, 7250, 6604, 6593, 4746, 7390, 7249, 7108, 6900, 6811, 3228,
+	6522, 6636, 6813, 3949, 4022, 4041, 5596, 6366, 6451, 6279, 6239, 6167, 6852,
+	4483, 6076, 6184, 3977, 4018, 4016, 5631, 6388, 6409, 6410, 6287, 6306, 7082,
+	6401, 4659, 6180, 4018, 4125, 4074, 5631, 6390, 6369, 6371, 6442, 6305, 7263,
+	6573, 6608, 4723, 7118, 6967, 6826, 6804, 3246, 6423, 6561, 6687, 3939, 3976,
+	3960, 3931, 5574, 6462, 6263, 6140, 6028, 6826, 4513, 6044, 6161, 3923, 4005,
+	4033, 4029, 5605, 6377, 6359, 6286, 6198, 6968, 6418, 4581, 6178, 4017, 4054,
+	4097, 4103, 5647, 6351, 6371, 6490, 6334, 7117, 6582, 6533, 4669, 6986, 6875,
+	6682, 3314, 6468, 6470, 6652, 4031, 4225, 4122, 4166, 4113, 5635, 6325, 6216,
+	6102, 6840, 4630, 5990, 6185, 4021, 4134, 4192, 4163, 4141, 5708, 6506, 6401,
+	6265, 6880, 6415, 4737, 6181, 4080, 4249, 4241, 4190, 4195, 5701, 6396, 6549,
+	6330, 7061, 6554, 6519, 4795, 6857, 6638, 3478, 6240, 6464, 6510, 4138, 4258,
+	4201, 4311, 4163, 4267, 5692, 6303, 6128, 6665, 4688, 6030, 6141, 4176, 4304,
+	4359, 4314, 4275, 4365, 5737, 6356, 6302, 6821, 6450, 4757, 6082, 4255, 4257,
+	4338, 4304, 4314, 4402, 5766, 6542, 6390, 6912, 6478, 6527, 4868, 6581, 3511,
+	6203, 6218, 6375, 4258, 4332, 4268, 4297, 4326, 4376, 4343, 5628, 6053, 6582,
+	4698, 5988, 6119, 4230, 4387, 4320, 4378, 4317, 4479, 4558, 5711, 6226, 6589,
+	6336, 4821, 6103, 4298, 4444, 4439, 4451, 4480, 4540, 4600, 5818, 6314, 6760,
+	6493, 6496, 4956, 3607, 6023, 6038, 6136, 4265, 4366, 4376, 4390, 4326, 4446,
+	4474, 4575, 5624, 6386, 4639, 5885, 5972, 4377, 4436, 4462, 4382, 4447, 4528,
+	4604, 4739, 5730, 6421, 6265, 4789, 5989, 4472, 4502, 4510, 4586, 4472, 4587,
+	4666, 4804, 5795, 6511, 6370, 6391, 5006, 8149, 8234, 8453, 6962, 6986, 6962,
+	6983, 6916, 7024, 7037, 6994, 7030, 8577, 8181, 8335, 8354, 7026, 6979, 6956,
+	6961, 6944, 6989, 7064, 7102, 7064, 8796, 8704, 8134, 8369, 7036, 7037, 6996,
+	6971, 6885, 6981, 7039, 7176, 7158, 8981, 8814, 8803, 8185, 5314, 5386, 3312,
+	3439, 3533, 3585, 3684, 3724, 3822, 3961, 4086, 5234, 3986, 5926, 5995, 4658,
+	4722, 4766, 4690, 4703, 4704, 4891, 4877, 5096, 5620, 6269, 5008, 5988, 4671,
+	4799, 4806, 4792, 4823, 4833, 4944, 5089, 5203, 5723, 6303, 6249, 5140, 5330,
+	4152, 4329, 4255, 4356, 4312, 4397, 4357, 4387, 4534, 4911, 3405, 5808, 5551,
+	3086, 3335, 3387, 3485, 3550, 3658, 3833, 3902, 4099, 5258, 6180, 3930, 5932,
+	4541, 4688, 4613, 4674, 4719, 4809, 4740, 4924, 5119, 5623, 5802, 6235, 5020,
+	4009, 4165, 4122, 4173, 4183, 4249, 4206, 4251, 4358, 4808, 3264, 5036, 5811,
+	4078, 4135, 4214, 4180, 4232, 4243, 4356, 4335, 4482, 4902, 5206, 3398, 5843,
+	3016, 3212, 3277, 3347, 3359, 3547, 3669, 3909, 4081, 5248, 6159, 6189, 3863,
+	7555, 7562, 7489, 7501, 7502, 7334, 7248, 7073, 7109, 3248, 6893, 7162, 5315,
+	6531, 6452, 6488, 6463, 6413, 6469, 6375, 6314, 6192, 7419, 4630, 6414, 5314,
+	6544, 6560, 6482, 6423, 6491, 6481, 6492, 6429, 6321, 7583, 6714, 4751, 7454,
+	7458, 7411, 7419, 7291, 7114, 7004, 6837, 3202, 6751, 6869, 3865, 5300, 6430,
+	6394, 6353, 6304, 6400, 6307, 6239, 6095, 7174, 4568, 6215, 3914, 5373, 6390,
+	6406, 6396, 6344, 6433, 6413, 6276, 6183, 7365, 6585, 4609, 7480, 7406, 7384,
+	7261, 7138, 6942, 6875, 3207, 6741, 6901, 3910, 3960, 5311, 6411, 6349, 6386,
+	6399, 6369, 6200, 6086, 7121, 4602, 6275, 4016, 4070, 5349, 6445, 6405, 6374,
+	6353, 6380, 6309, 6241, 7343, 6634, 4695, 7379, 7317, 7183, 7095, 6884, 6769,
+	3261, 6606, 6749, 3966, 3955, 3993, 5345, 6399, 6359, 6419, 6308, 6192, 6054,
+	7099, 4561, 6230, 3952, 4118, 4061, 5349, 6424, 6348, 6422, 6442, 6325, 6185,
+	7200, 6611, 4680, 7306, 7218, 6984, 6843, 6691, 3229, 6568, 6683, 3954, 4074,
+	4046, 4015, 5317, 6373, 6393, 6252, 6131, 6077, 6940, 4645, 6131, 4006, 4070,
+	4105, 4060, 5330, 6360, 6447, 6459, 6317, 6176, 7101, 6574, 4719, 7014, 6874,
+	6722, 6589, 3221, 6449, 6613, 3918, 4062, 4067, 4009, 3986, 5306, 6364, 6221,
+	6144, 5971, 6847, 4536, 6174, 3938, 4098, 4086, 4045, 4033, 5326, 6331, 6305,
+	6247, 6078, 7029, 6474, 4645, 6866, 6693, 6520, 3312, 6426, 6391, 4077, 4147,
+	4214, 4159, 4153, 4246, 5396, 6241, 6098, 5996, 6803, 4697, 6060, 4132, 4184,
+	4153, 4195, 4167, 4248, 5422, 6400, 6239, 6102, 6845, 6483, 4731, 6565, 6425,
+	3410, 6135, 6390, 4121, 4244, 4201, 4225, 4293, 4285, 4362, 5395, 6057, 5905,
+	6545, 4637, 6005, 4229, 4337, 4317, 4300, 4315, 4316, 4459, 5481, 6250, 6086,
+	6782, 6401, 4806, 6270, 3564, 6002, 6067, 4211, 4271, 4313, 4310, 4341, 4302,
+	4443, 4525, 5400, 5903, 6436, 4701, 5911, 4312, 4380, 4400, 4339, 4379, 4391,
+	4506, 4676, 5470, 5923, 6476, 6256, 4840, 3695, 5691, 5827, 4308, 4411, 4466,
+	4443, 4458, 4451, 4625, 4632, 4817, 5346, 6102, 4750, 5715, 4388, 4485, 4491,
+	4486, 4487, 4526, 4637, 4819, 4977, 5481, 6164, 6051, 4895, 8265, 8450, 6960,
+	6951, 6936, 6925, 6875, 6902, 6941, 6970, 6990, 6987, 8744, 8162, 8338, 6948,
+	6974, 6975, 6931, 6899, 6940, 7020, 7089, 7047, 7000, 8898, 8732, 8095, 5297,
+	3096, 3304, 3353, 3493, 3529, 3649, 3780, 3853, 4099, 4319, 5248, 3861, 5374,
+	4484, 4619, 4690, 4668, 4648, 4669, 4738, 4978, 5115, 5328, 5623, 5716, 4976,
+	4050, 4211, 4170, 4192, 4141, 4204, 4319, 4315, 4486, 4702, 4902, 3342, 5351,
+	3041, 3148, 3216, 3352, 3359, 3450, 3660, 3907, 4089, 4364, 5224, 5588, 3764,
+	7588, 7594, 7555, 7473, 7523, 7455, 7417, 7142, 7314, 7278, 3318, 7128, 5296,
+	6486, 6528, 6427, 6431, 6495, 6392, 6491, 6452, 6355, 6333, 7534, 4606, 7470,
+	7465, 7456, 7398, 7423, 7285, 7123, 7098, 7065, 3184, 6918, 3858, 5300, 6481,
+	6424, 6341, 6299, 6372, 6376, 6247, 6162, 6180, 7369, 4578, 7505, 7444, 7366,
+	7372, 7259, 7064, 6964, 7049, 3269, 6864, 3877, 3981, 5312, 6411, 6366, 6297,
+	6242, 6417, 6269, 6183, 6204, 7307, 4626, 7433, 7403, 7353, 7266, 7087, 6922,
+	6910, 3287, 6813, 3869, 4009, 3988, 5292, 6420, 6357, 6362, 6343, 6255, 6189,
+	6187, 7260, 4648, 7329, 7277, 7149, 6986, 6858, 6889, 3322, 6702, 3987, 4070,
+	4056, 4042, 5313, 6346, 6354, 6373, 6327, 6160, 6146, 7146, 4758, 7239, 7039,
+	6896, 6690, 6760, 3251, 6598, 3949, 4041, 4073, 4075, 4080, 5319, 6340, 6343,
+	6235, 6108, 6138, 7044, 4655, 6864, 6700, 6537, 6574, 3222, 6452, 3950, 3999,
+	4091, 4019, 4009, 4038, 5327, 6264, 6158, 6002, 5999, 6866, 4649, 6612, 6449,
+	6478, 3354, 6378, 4091, 4143, 4198, 4176, 4205, 4225, 4308, 5395, 6039, 5959,
+	5984, 6786, 4724, 6278, 6274, 3480, 6012, 4111, 4264, 4261, 4252, 4238, 4264,
+	4331, 4522, 5427, 5819, 5875, 6365, 4703, 5911, 3567, 5746, 4276, 4389, 4352,
+	4349, 4360, 4351, 4540, 4664, 4754, 5371, 5553, 6057, 4784, 3466, 5175, 4175,
+	4329, 4315, 4305, 4273, 4390, 4407, 4596, 4837, 5079, 5358, 5468, 4763, 8391,
+	6867, 6922, 6887, 6841, 6872, 6907, 6858, 6987, 6985, 6930, 7009, 8961, 8070,
+	2956, 3138, 3225, 3257, 3304, 3477, 3593, 3806, 4019, 4339, 4904, 5241, 3756,
+	7619, 7574, 7549, 7532, 7575, 7505, 7491, 7321, 7422, 7392, 7520, 3415, 7564,
+	7473, 7472, 7462, 7414, 7457, 7325, 7168, 7261, 7236, 3363, 7493, 7399, 7414,
+	7390, 7429, 7228, 7124, 7138, 7251, 3281, 7397, 7375, 7363, 7369, 7257, 7113,
+	7118, 7169, 3284, 7325, 7287, 7287, 7123, 6999, 7053, 7047, 3324, 7225, 7187,
+	7024, 6844, 6925, 6911, 3369, 7052, 6861, 6715, 6732, 6781, 3328, 6630, 6466,
+	6451, 6520, 3262, 6261, 6277, 6291, 3333, 6009, 6019, 3453, 5318, 3387, 3375,
+}
@@ -0,0 +1,280 @@
+package hand
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A Combo is one set of hole cards in a Range.
+type Combo struct {
+	// Cards are the hole cards of the combo.
+	Cards []*Card `json:"cards"`
+
+	// Weight is the relative likelihood of the combo.  A weight of one
+	// is a combo that is always played.
+	Weight float64 `json:"weight"`
+}
+
+// String returns a string in the format "A♠K♠:0.5".  The weight is
+// omitted if it is one.
+func (c Combo) String() string {
+	s := ""
+	for _, card := range c.Cards {
+		s += card.String()
+	}
+	if c.Weight != 1 {
+		s += ":" + strconv.FormatFloat(c.Weight, 'g', -1, 64)
+	}
+	return s
+}
+
+// A Range is a weighted set of hole cards a player might hold.
+type Range struct {
+	combos []Combo
+}
+
+// NewRange returns a range of the given combos.  A later combo with the
+// same cards as an earlier one replaces it.
+func NewRange(combos ...Combo) *Range {
+	r := &Range{}
+	index := map[CardSet]int{}
+	for _, c := range combos {
+		mask := newEquityCombo(c.Cards, c.Weight).mask
+		if i, ok := index[mask]; ok {
+			r.combos[i] = c
+			continue
+		}
+		index[mask] = len(r.combos)
+		r.combos = append(r.combos, c)
+	}
+	return r
+}
+
+// ParseRange parses a range written in standard notation such as
+// "TT+, AKs, A2s-A5s, KQo, 76s-54s".  Hands are separated by commas or
+// spaces and may be:
+//
+//	AA, AKs, AKo, AK  a pair, suited, offsuit or any hand
+//	TT+, A9s+         a pair and every higher pair or a hand and every
+//	                  higher kicker
+//	TT-77, A2s-A5s    every pair or kicker between the two hands
+//	76s-54s           every hand between the two with the same gap
+//	AsKs              a specific combo
+//
+// A hand followed by a colon and a number such as "AA:0.5" gives its
+// combos that weight.  The hands of a range are expanded into every
+// matching combo.
+func ParseRange(s string) (*Range, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("hand: range %q is empty", s)
+	}
+
+	combos := []Combo{}
+	for _, field := range fields {
+		notation := field
+		weight := 1.0
+		if i := strings.Index(field, ":"); i != -1 {
+			w, err := strconv.ParseFloat(field[i+1:], 64)
+			if err != nil || w <= 0 {
+				return nil, fmt.Errorf("hand: range hand %q has an invalid weight", field)
+			}
+			notation, weight = field[:i], w
+		}
+
+		cards, err := parseRangeHand(notation)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cards {
+			combos = append(combos, Combo{Cards: c, Weight: weight})
+		}
+	}
+	return NewRange(combos...), nil
+}
+
+// Combos returns the combos of the range.
+func (r *Range) Combos() []Combo {
+	c := []Combo{}
+	return append(c, r.combos...)
+}
+
+// Len returns the number of combos in the range.
+func (r *Range) Len() int {
+	return len(r.combos)
+}
+
+// Without returns a range without the combos that contain any of the
+// given cards, such as the board or dead cards.
+func (r *Range) Without(cards ...*Card) *Range {
+	used := NewCardSet(cards...)
+	without := &Range{}
+	for _, combo := range r.combos {
+		if NewCardSet(combo.Cards...).Intersect(used).Count() == 0 {
+			without.combos = append(without.combos, combo)
+		}
+	}
+	return without
+}
+
+// String returns the combos of the range separated by commas.
+func (r *Range) String() string {
+	s := []string{}
+	for _, c := range r.combos {
+		s = append(s, c.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// rangeHand is a starting hand class such as "AKs".  hi and lo are rank
+// indexes and suited is 's', 'o' or zero for any suits.
+type rangeHand struct {
+	hi     int
+	lo     int
+	suited byte
+}
+
+func (h rangeHand) pair() bool {
+	return h.hi == h.lo
+}
+
+// combos returns every combo of the hand class.
+func (h rangeHand) combos() [][]*Card {
+	combos := [][]*Card{}
+	for s1 := 0; s1 < 4; s1++ {
+		for s2 := 0; s2 < 4; s2++ {
+			switch {
+			case h.pair() && s2 <= s1:
+				continue
+			case h.suited == 's' && s1 != s2:
+				continue
+			case h.suited == 'o' && s1 == s2:
+				continue
+			}
+			combos = append(combos, []*Card{rankedCard(h.hi, s1), rankedCard(h.lo, s2)})
+		}
+	}
+	return combos
+}
+
+func parseRangeHand(s string) ([][]*Card, error) {
+	errRange := fmt.Errorf("hand: range hand %q is invalid", s)
+
+	// specific combo such as AsKs
+	if len(s) == 4 && !strings.ContainsAny(s, "+-") {
+		c1, ok1 := rangeCard(s[:2])
+		c2, ok2 := rangeCard(s[2:])
+		if ok1 && ok2 && c1 != c2 {
+			return [][]*Card{{c1, c2}}, nil
+		}
+		if ok1 || ok2 {
+			return nil, errRange
+		}
+	}
+
+	hands := []rangeHand{}
+	switch {
+	case strings.HasSuffix(s, "+"):
+		h, ok := parseRangeClass(s[:len(s)-1])
+		if !ok {
+			return nil, errRange
+		}
+		if h.pair() {
+			for r := h.hi; r <= Ace.indexOf(); r++ {
+				hands = append(hands, rangeHand{hi: r, lo: r})
+			}
+		} else {
+			for r := h.lo; r < h.hi; r++ {
+				hands = append(hands, rangeHand{hi: h.hi, lo: r, suited: h.suited})
+			}
+		}
+	case strings.Contains(s, "-"):
+		parts := strings.Split(s, "-")
+		if len(parts) != 2 {
+			return nil, errRange
+		}
+		h1, ok1 := parseRangeClass(parts[0])
+		h2, ok2 := parseRangeClass(parts[1])
+		if !ok1 || !ok2 || h1.suited != h2.suited || h1.pair() != h2.pair() {
+			return nil, errRange
+		}
+		if h1.lo < h2.lo {
+			h1, h2 = h2, h1
+		}
+		switch {
+		case h1.pair():
+			for r := h2.hi; r <= h1.hi; r++ {
+				hands = append(hands, rangeHand{hi: r, lo: r})
+			}
+		case h1.hi == h2.hi:
+			for r := h2.lo; r <= h1.lo; r++ {
+				hands = append(hands, rangeHand{hi: h1.hi, lo: r, suited: h1.suited})
+			}
+		case h1.hi-h1.lo == h2.hi-h2.lo:
+			for r := h2.lo; r <= h1.lo; r++ {
+				hands = append(hands, rangeHand{hi: r + h1.hi - h1.lo, lo: r, suited: h1.suited})
+			}
+		default:
+			return nil, errRange
+		}
+	default:
+		h, ok := parseRangeClass(s)
+		if !ok {
+			return nil, errRange
+		}
+		hands = append(hands, h)
+	}
+
+	combos := [][]*Card{}
+	for _, h := range hands {
+		combos = append(combos, h.combos()...)
+	}
+	return combos, nil
+}
+
+// parseRangeClass parses a hand class such as "AKs", "AKo", "AK" or "AA".
+func parseRangeClass(s string) (rangeHand, bool) {
+	if len(s) != 2 && len(s) != 3 {
+		return rangeHand{}, false
+	}
+	r1, ok1 := rangeRank(s[0])
+	r2, ok2 := rangeRank(s[1])
+	if !ok1 || !ok2 {
+		return rangeHand{}, false
+	}
+	if r1 < r2 {
+		r1, r2 = r2, r1
+	}
+	h := rangeHand{hi: r1, lo: r2}
+	if len(s) == 3 {
+		h.suited = byte(unicode.ToLower(rune(s[2])))
+		if h.pair() || (h.suited != 's' && h.suited != 'o') {
+			return rangeHand{}, false
+		}
+	}
+	return h, true
+}
+
+// rangeRank returns the rank index of a rank character such as 'T'.
+func rangeRank(b byte) (int, bool) {
+	r := Rank(strings.ToUpper(string(b)))
+	i := r.indexOf()
+	return i, i != -1
+}
+
+// rangeCard returns the card of a two character string such as "As".
+func rangeCard(s string) (*Card, bool) {
+	c, err := ParseCard(s)
+	return c, err == nil && !c.IsJoker()
+}
+
+// rankedCard returns the card of the rank index and suit index where
+// suits are ordered spades, hearts, diamonds and clubs.
+func rankedCard(rank, suit int) *Card {
+	// Cards is ordered by suit and then from ace down to two
+	return Cards()[suit*13+Ace.indexOf()-rank]
+}
@@ -0,0 +1,135 @@
+package hand_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+var rangeTests = []struct {
+	notation string
+	combos   int
+}{
+	{"AA", 6},
+	{"AKs", 4},
+	{"AKo", 12},
+	{"AK", 16},
+	{"TT+", 30},
+	{"A9s+", 20},
+	{"TT-77", 24},
+	{"A2s-A5s", 16},
+	{"76s-54s", 12},
+	{"AsKs", 1},
+	{"TT+, AKs, A2s-A5s, KQo, 76s-54s", 74},
+	{"AA AKs,AKs", 10},
+	{"aks", 4},
+}
+
+func TestParseRange(t *testing.T) {
+	for _, test := range rangeTests {
+		r, err := ParseRange(test.notation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Len() != test.combos {
+			t.Fatalf("ParseRange(%q) = %d combos; want %d", test.notation, r.Len(), test.combos)
+		}
+	}
+}
+
+func TestParseRangeWeights(t *testing.T) {
+	r, err := ParseRange("AA:0.5, KK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range r.Combos() {
+		weight := 1.0
+		if c.Cards[0].Rank() == Ace {
+			weight = 0.5
+		}
+		if c.Weight != weight {
+			t.Fatalf("ParseRange() combo %v weight = %v; want %v", c, c.Weight, weight)
+		}
+	}
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	for _, notation := range []string{"", "AAs", "AKx", "A1s", "AKs-QQ", "AKs-T9o", "A5s-K3s", "AA:0", "AA:x", "AsAs", "AsKx"} {
+		if _, err := ParseRange(notation); err == nil {
+			t.Fatalf("ParseRange(%q) should return an error", notation)
+		}
+	}
+}
+
+func TestRangeWithout(t *testing.T) {
+	r, err := ParseRange("AA, AKs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = r.Without(pokertest.Cards("As", "2c")...)
+	if r.Len() != 6 {
+		t.Fatalf("Without() = %d combos; want %d", r.Len(), 6)
+	}
+}
+
+func TestRangeEquity(t *testing.T) {
+	aces, _ := ParseRange("AA")
+	kings, _ := ParseRange("KK")
+	board := pokertest.Cards("2c", "7d", "9h", "Jc")
+	r, err := RangeEquity([]*Range{aces, kings}, board, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Exact {
+		t.Fatal("RangeEquity() should enumerate on the turn")
+	}
+	// kings need one of the two kings left in the deck
+	if e := r.Players[1].Equity; math.Abs(e-100*2.0/44.0) > 0.001 {
+		t.Fatalf("RangeEquity() kings = %v; want %.2f%% equity", r.Players[1], 100*2.0/44.0)
+	}
+
+	// range versus hand
+	hand := NewRange(Combo{Cards: pokertest.Cards("Ks", "Kh"), Weight: 1})
+	r, err = RangeEquity([]*Range{aces, hand}, board, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := r.Players[1].Equity; math.Abs(e-100*2.0/44.0) > 0.001 {
+		t.Fatalf("RangeEquity() kings = %v; want %.2f%% equity", r.Players[1], 100*2.0/44.0)
+	}
+}
+
+func TestRangeEquitySampling(t *testing.T) {
+	r1, _ := ParseRange("QQ+, AK")
+	r2, _ := ParseRange("22+, A2s+, KTs+, QTs+, JTs, ATo+, KJo+")
+	options := []func(*EquityConfig){EquitySeed(1), EquityWorkers(2), EquityTrials(20000)}
+	r, err := RangeEquity([]*Range{r1, r2}, nil, nil, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Exact || r.Boards != 20000 {
+		t.Fatalf("RangeEquity() = %d boards, exact %v; want 20000 sampled boards", r.Boards, r.Exact)
+	}
+	if e := r.Players[0].Equity + r.Players[1].Equity; math.Abs(e-100) > 0.001 {
+		t.Fatalf("RangeEquity() equities total %.2f%%; want 100%%", e)
+	}
+	if r.Players[0].Equity < 55 || r.Players[0].Equity > 70 {
+		t.Fatalf("RangeEquity() tight range = %v; want about 62%% equity", r.Players[0])
+	}
+}
+
+func TestRangeEquityNoCombos(t *testing.T) {
+	aces, _ := ParseRange("AA")
+	board := pokertest.Cards("As", "Ah", "Ad")
+	if _, err := RangeEquity([]*Range{aces, aces}, board, nil); err == nil {
+		t.Fatal("RangeEquity() with no combos left should return an error")
+	}
+
+	r1, _ := ParseRange("AsAh")
+	r2, _ := ParseRange("AsAd")
+	if _, err := RangeEquity([]*Range{r1, r2}, nil, nil, EquityMaxEnumerations(0)); err != ErrNoCompatibleCombos {
+		t.Fatalf("RangeEquity() with conflicting ranges err = %v; want %v", err, ErrNoCompatibleCombos)
+	}
+}
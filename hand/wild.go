@@ -0,0 +1,246 @@
+package hand
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrUnnamedWild errors occur when a hand made with a wild predicate
+// other than DeucesWild is serialized.
+var ErrUnnamedWild = errors.New("hand: only the DeucesWild wild predicate can be serialized")
+
+// wildModes are the wild predicates that can be serialized by name.
+var wildModes = map[string]func(*Card) bool{
+	"deuces": DeucesWild,
+}
+
+// Wild configures NewHand to treat the cards matching isWild as wild.
+// Jokers are always wild.  A wild card stands in for the card not already
+// in the hand that makes the best hand, or completes five of a kind which
+// beats a royal flush.  With a low sorting a wild card becomes the lowest
+// card missing from the hand, the same as a joker played as the bug in
+// lowball.
+func Wild(isWild func(*Card) bool) func(*Config) {
+	return func(c *Config) {
+		c.wild = isWild
+	}
+}
+
+// DeucesWild is a wild card predicate for games in which twos are wild.
+func DeucesWild(c *Card) bool {
+	return c.Rank() == Two
+}
+
+// Jokers configures Cards and NewDealer to add n jokers to the deck.
+// Every joker is JokerCard, so jokers can't be told apart and can't be in
+// a CardSet.  They are for hand evaluation and equity only, and the table
+// packages panic if a dealer deals them.
+func Jokers(n int) func(*Config) {
+	return func(c *Config) {
+		c.jokers = n
+	}
+}
+
+// wildMode returns the name of the config's wild predicate, which is
+// empty without one.  Functions can't be compared so the predicates are
+// matched by their code pointers.
+func (c Config) wildMode() (string, error) {
+	if c.wild == nil {
+		return "", nil
+	}
+	p := reflect.ValueOf(c.wild).Pointer()
+	for name, isWild := range wildModes {
+		if reflect.ValueOf(isWild).Pointer() == p {
+			return name, nil
+		}
+	}
+	return "", ErrUnnamedWild
+}
+
+func (c Config) isWild(card *Card) bool {
+	return card.IsJoker() || (c.wild != nil && c.wild(card))
+}
+
+func (c Config) hasWilds(cards []*Card) bool {
+	for _, card := range cards {
+		if c.isWild(card) {
+			return true
+		}
+	}
+	return false
+}
+
+// A wildResult is cards with the wild cards replaced by the cards they
+// stand in for.
+type wildResult struct {
+	cards    []*Card
+	wilds    map[*Card]*Card
+	strength int
+}
+
+// wildHand returns the best hand of the cards with every wild card
+// standing in for its best card.  The hand's cards are the original
+// cards, so wild cards are included as they are.
+func wildHand(cards []*Card, c Config) *Hand {
+	size := 5
+	if len(cards) < size {
+		size = len(cards)
+	}
+	low := c.sorting == SortingLow
+
+	var best *wildResult
+	for _, combo := range indexCombos(len(cards), size) {
+		selected := make([]*Card, size)
+		for i, index := range combo {
+			selected[i] = cards[index]
+		}
+		r := resolveWilds(selected, c)
+		if best == nil || (low && r.strength < best.strength) || (!low && r.strength > best.strength) {
+			best = r
+		}
+	}
+
+	h := handForFiveCards(best.cards, c)
+	for i, card := range h.cards {
+		if wild, ok := best.wilds[card]; ok {
+			h.cards[i] = wild
+		}
+	}
+	return h
+}
+
+// resolveWilds tries every rank for each wild card of five or fewer cards
+// and returns the best result.  Only the suits of a flush and of a hand
+// that isn't a flush need to be tried for each choice of ranks.
+func resolveWilds(cards []*Card, c Config) *wildResult {
+	naturals := []*Card{}
+	wilds := []*Card{}
+	for _, card := range cards {
+		if c.isWild(card) {
+			wilds = append(wilds, card)
+		} else {
+			naturals = append(naturals, card)
+		}
+	}
+
+	ranks := []int{}
+	for i, r := range allRanks() {
+		if c.inDeck(&Card{TheRank: r}) {
+			ranks = append(ranks, i)
+		}
+	}
+
+	low := c.sorting == SortingLow
+	var best *wildResult
+	try := func(r *wildResult) {
+		if r != nil && (best == nil || (low && r.strength < best.strength) || (!low && r.strength > best.strength)) {
+			best = r
+		}
+	}
+
+	chosen := make([]int, len(wilds))
+	var choose func(i, start int)
+	choose = func(i, start int) {
+		if i == len(wilds) {
+			try(substituteWilds(naturals, wilds, chosen, true, c))
+			try(substituteWilds(naturals, wilds, chosen, false, c))
+			return
+		}
+		for j := start; j < len(ranks); j++ {
+			chosen[i] = ranks[j]
+			choose(i+1, j)
+		}
+	}
+	choose(0, 0)
+	return best
+}
+
+// substituteWilds replaces the wild cards with cards of the chosen rank
+// indexes.  If flush is true the wild cards take the suit of the natural
+// cards, otherwise they take suits that don't form a flush.  nil is
+// returned if the cards can't be formed, such as a flush with a pair or
+// the same card twice.
+func substituteWilds(naturals, wilds []*Card, chosen []int, flush bool, c Config) *wildResult {
+	r := &wildResult{wilds: map[*Card]*Card{}}
+	r.cards = append(r.cards, naturals...)
+	if len(wilds) == 0 {
+		// the natural cards are only tried once
+		if !flush {
+			return nil
+		}
+		r.strength = wildStrength(r.cards, c)
+		return r
+	}
+
+	ranks := allRanks()
+	suits := allSuits()
+	used := map[Card]bool{}
+	counts := map[Rank]int{}
+	for _, card := range naturals {
+		used[*card] = true
+		counts[card.Rank()]++
+	}
+	for _, r := range chosen {
+		counts[ranks[r]]++
+	}
+
+	fiveOfAKind := false
+	for _, n := range counts {
+		fiveOfAKind = fiveOfAKind || n == 5
+	}
+	if fiveOfAKind && !flush {
+		// suits don't matter for five of a kind so it is only tried once
+		return nil
+	}
+
+	flushSuit := Spades
+	if len(naturals) > 0 {
+		flushSuit = naturals[0].Suit()
+	}
+	if flush && !fiveOfAKind {
+		for _, card := range naturals {
+			if card.Suit() != flushSuit {
+				return nil
+			}
+		}
+		for _, n := range counts {
+			if n > 1 {
+				return nil
+			}
+		}
+	}
+	for i, rank := range chosen {
+		card := &Card{TheRank: ranks[rank], TheSuit: flushSuit}
+		if !flush || fiveOfAKind {
+			// prefer another suit so the hand isn't a flush
+			for _, s := range append(suits, flushSuit) {
+				card.TheSuit = s
+				if !used[*card] && (s != flushSuit || fiveOfAKind) {
+					break
+				}
+			}
+		}
+		used[*card] = true
+		r.wilds[card] = wilds[i]
+		r.cards = append(r.cards, card)
+	}
+
+	r.strength = wildStrength(r.cards, c)
+	return r
+}
+
+// wildStrength returns the strength of five or fewer cards without wild
+// cards.  Five cards without five of a kind use the lookup tables.
+func wildStrength(cards []*Card, c Config) int {
+	if len(cards) != 5 {
+		return handForFiveCards(copyCards(cards), c).strength
+	}
+	codes := make([]cardCode, 5)
+	for i, card := range cards {
+		codes[i] = encodeCard(card)
+	}
+	if s := c.evalTable().eval(codes[0], codes[1], codes[2], codes[3], codes[4]); s != 0 {
+		return s
+	}
+	return handForFiveCards(copyCards(cards), c).strength
+}
@@ -0,0 +1,178 @@
+package hand_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	. "github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+var wildTests = []struct {
+	cards       []*Card
+	options     []func(*Config)
+	ranking     Ranking
+	description string
+}{
+	{
+		append(pokertest.Cards("As", "Ah", "Ad", "Ac"), JokerCard),
+		nil,
+		FiveOfAKind,
+		"five of a kind aces",
+	},
+	{
+		append(pokertest.Cards("Ks", "Qs", "Js", "Ts", "2d"), JokerCard),
+		nil,
+		RoyalFlush,
+		"royal flush",
+	},
+	{
+		pokertest.Cards("2c", "2d", "7h", "7s", "Kd"),
+		[]func(*Config){Wild(DeucesWild)},
+		FourOfAKind,
+		"four of a kind sevens",
+	},
+	{
+		pokertest.Cards("2c", "2d", "2h", "7s", "7d"),
+		[]func(*Config){Wild(DeucesWild)},
+		FiveOfAKind,
+		"five of a kind sevens",
+	},
+	{
+		append(pokertest.Cards("2s", "3h", "4d", "7c", "Kc"), JokerCard),
+		[]func(*Config){AceToFiveLow},
+		HighCard,
+		"high card seven high",
+	},
+	{
+		append(pokertest.Cards("2s", "3h", "4d", "5c"), JokerCard),
+		[]func(*Config){DeuceToSevenLow},
+		HighCard,
+		"seven-five low",
+	},
+}
+
+func TestWildHands(t *testing.T) {
+	for _, test := range wildTests {
+		h := New(test.cards, test.options...)
+		if h.Ranking() != test.ranking {
+			t.Fatalf("New(%v).Ranking() = %v; want %v", test.cards, h.Ranking(), test.ranking)
+		}
+		if h.Description() != test.description {
+			t.Fatalf("New(%v).Description() = %q; want %q", test.cards, h.Description(), test.description)
+		}
+		if s := Evaluate(test.cards, test.options...); s != h.Strength() {
+			t.Fatalf("Evaluate(%v) = %d; want %d", test.cards, s, h.Strength())
+		}
+	}
+
+	fiveOfAKind := New(append(pokertest.Cards("2s", "2h", "2d", "2c"), JokerCard))
+	royalFlush := New(pokertest.Cards("As", "Ks", "Qs", "Js", "Ts"))
+	if fiveOfAKind.CompareTo(royalFlush) <= 0 {
+		t.Fatalf("expected %v to be greater than %v", fiveOfAKind, royalFlush)
+	}
+
+	h := New(append(pokertest.Cards("Ks", "Qs", "Js", "Ts"), JokerCard))
+	if h.Cards()[0] != JokerCard {
+		t.Fatalf("New().Cards() = %v; want the joker as the ace", h.Cards())
+	}
+}
+
+// TestJokerMatchesSubstitution checks a joker makes the same hand as the
+// best card that could replace it.
+func TestJokerMatchesSubstitution(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	configs := [][]func(*Config){nil, {Low}, {AceToFiveLow}, {DeuceToSevenLow}}
+	for i := 0; i < 200; i++ {
+		deck := Cards()
+		r.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+		naturals := deck[:4+i%3]
+		cards := append(append([]*Card{}, naturals...), JokerCard)
+		for _, options := range configs {
+			low := len(options) > 0
+			var best *Hand
+			for _, c := range deck[len(naturals):] {
+				h := New(append(append([]*Card{}, naturals...), c), options...)
+				if best == nil || (low && h.CompareTo(best) < 0) || (!low && h.CompareTo(best) > 0) {
+					best = h
+				}
+			}
+			h := New(cards, options...)
+			if h.Ranking() == FiveOfAKind {
+				continue
+			}
+			if h.CompareTo(best) != 0 || h.Description() != best.Description() {
+				t.Fatalf("New(%v) = %v; want %v", cards, h, best)
+			}
+		}
+	}
+}
+
+func TestJokerText(t *testing.T) {
+	b, err := JokerCard.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Card{}
+	if err := c.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if !c.IsJoker() || *c != *JokerCard {
+		t.Fatalf("text round trip = %v; want %v", c, JokerCard)
+	}
+
+	deck := NewDealer(Jokers(2)).Deck()
+	if l := len(deck.Cards); l != 54 {
+		t.Fatalf("deck with jokers len = %d; want %d", l, 54)
+	}
+	b, err = deck.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deckCopy := &Deck{}
+	if err := deckCopy.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	jokers := 0
+	for i, c := range deckCopy.Cards {
+		if *c != *deck.Cards[i] {
+			t.Fatalf("deck text round trip = %v; want %v", deckCopy, deck)
+		}
+		if c.IsJoker() {
+			jokers++
+		}
+	}
+	if jokers != 2 {
+		t.Fatalf("deck text round trip has %d jokers; want %d", jokers, 2)
+	}
+}
+
+func TestWildJSON(t *testing.T) {
+	cards := pokertest.Cards("2s", "Kh", "Kd", "7c", "4s")
+	h := New(cards, Wild(DeucesWild))
+	b, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2 := &Hand{}
+	if err := json.Unmarshal(b, h2); err != nil {
+		t.Fatal(err)
+	}
+	if h2.Ranking() != ThreeOfAKind || h2.CompareTo(h) != 0 {
+		t.Fatalf("json.Unmarshal(%s) = %v; want %v", b, h2, h)
+	}
+
+	oneEyedJacks := func(c *Card) bool {
+		return c.Rank() == Jack && (c.Suit() == Spades || c.Suit() == Hearts)
+	}
+	if _, err := json.Marshal(New(cards, Wild(oneEyedJacks))); err == nil {
+		t.Fatal("json.Marshal() of a hand with a custom wild predicate should error")
+	}
+
+	j := h.HandJSON()
+	j.Config.Wild = "sevens"
+	if _, err := j.Hand(); err == nil {
+		t.Fatal("HandJSON.Hand() with an unknown wild mode should error")
+	}
+}
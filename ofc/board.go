@@ -0,0 +1,258 @@
+package ofc
+
+import (
+	"fmt"
+
+	"github.com/rolends1986/poker/hand"
+)
+
+// A Row is one of the three rows of a Board.
+type Row int
+
+const (
+	// Front is the three card top row.
+	Front Row = iota
+
+	// Middle is the five card middle row.
+	Middle
+
+	// Back is the five card bottom row.
+	Back
+)
+
+// Rows returns all Rows from front to back.
+func Rows() []Row {
+	return []Row{Front, Middle, Back}
+}
+
+// Size returns the number of cards in the row.
+func (r Row) Size() int {
+	if r == Front {
+		return 3
+	}
+	return 5
+}
+
+// String returns the name of the row such as "Front".
+func (r Row) String() string {
+	switch r {
+	case Front:
+		return "Front"
+	case Middle:
+		return "Middle"
+	case Back:
+		return "Back"
+	}
+	return fmt.Sprintf("Row(%d)", int(r))
+}
+
+// A Board is the cards a player has placed in the three rows.
+type Board struct {
+	Front  []*hand.Card `json:"front" bson:"front"`
+	Middle []*hand.Card `json:"middle" bson:"middle"`
+	Back   []*hand.Card `json:"back" bson:"back"`
+}
+
+// Row returns the cards placed in the row.
+func (b *Board) Row(r Row) []*hand.Card {
+	switch r {
+	case Front:
+		return b.Front
+	case Middle:
+		return b.Middle
+	case Back:
+		return b.Back
+	}
+	return nil
+}
+
+// Len returns the number of cards placed.
+func (b *Board) Len() int {
+	return len(b.Front) + len(b.Middle) + len(b.Back)
+}
+
+// Complete returns whether every row is full.
+func (b *Board) Complete() bool {
+	for _, r := range Rows() {
+		if len(b.Row(r)) != r.Size() {
+			return false
+		}
+	}
+	return true
+}
+
+// Hand returns the hand of the row.  The front is formed with
+// hand.NewFront so it compares with the five card rows.
+func (b *Board) Hand(r Row) *hand.Hand {
+	if r == Front {
+		return hand.NewFront(b.Front)
+	}
+	return hand.New(b.Row(r))
+}
+
+// Fouled returns whether the complete board's rows aren't in order of
+// strength, so the front beats the middle or the middle beats the back.
+// An incomplete board isn't fouled.
+func (b *Board) Fouled() bool {
+	if !b.Complete() {
+		return false
+	}
+	front, middle, back := b.Hand(Front), b.Hand(Middle), b.Hand(Back)
+	return front.CompareTo(middle) > 0 || middle.CompareTo(back) > 0
+}
+
+// Royalties returns the bonus points of the complete board's rows, or
+// zero if the board is incomplete or fouled.
+func (b *Board) Royalties() int {
+	if !b.Complete() || b.Fouled() {
+		return 0
+	}
+	royalties := 0
+	for _, r := range Rows() {
+		royalties += b.RowRoyalty(r)
+	}
+	return royalties
+}
+
+// RowRoyalty returns the bonus points of the row's hand.  A front pair of
+// sixes scores one point up to nine for aces, and three of a kind scores
+// ten for deuces up to twenty two for aces.  The middle and back score
+// the hands of middleRoyalties and backRoyalties.
+func (b *Board) RowRoyalty(r Row) int {
+	if len(b.Row(r)) != r.Size() {
+		return 0
+	}
+	h := b.Hand(r)
+	switch r {
+	case Front:
+		rank := h.Cards()[0].Index() / 4
+		switch h.Ranking() {
+		case hand.Pair:
+			if rank >= sixes {
+				return rank - sixes + 1
+			}
+		case hand.ThreeOfAKind:
+			return rank + 10
+		}
+		return 0
+	case Middle:
+		return middleRoyalties[h.Ranking()]
+	}
+	return backRoyalties[h.Ranking()]
+}
+
+// Fantasyland returns whether the board earns Fantasyland, which takes a
+// front of queens or better without fouling.
+func (b *Board) Fantasyland() bool {
+	if !b.Complete() || b.Fouled() {
+		return false
+	}
+	front := b.Hand(Front)
+	switch front.Ranking() {
+	case hand.Pair:
+		return front.Cards()[0].Index()/4 >= queens
+	case hand.ThreeOfAKind:
+		return true
+	}
+	return false
+}
+
+// StaysInFantasyland returns whether a board played in Fantasyland earns
+// it again, which takes three of a kind in the front, a full house or
+// better in the middle or four of a kind or better in the back without
+// fouling.
+func (b *Board) StaysInFantasyland() bool {
+	if !b.Complete() || b.Fouled() {
+		return false
+	}
+	return b.Hand(Front).Ranking() == hand.ThreeOfAKind ||
+		b.Hand(Middle).Ranking() >= hand.FullHouse ||
+		b.Hand(Back).Ranking() >= hand.FourOfAKind
+}
+
+// Cards returns the cards placed from front to back.
+func (b *Board) Cards() []*hand.Card {
+	cards := []*hand.Card{}
+	for _, r := range Rows() {
+		cards = append(cards, b.Row(r)...)
+	}
+	return cards
+}
+
+// String returns a string useful for debugging.
+func (b *Board) String() string {
+	return fmt.Sprintf("{Front: %v, Middle: %v, Back: %v}", b.Front, b.Middle, b.Back)
+}
+
+// add places the cards in the row.
+func (b *Board) add(r Row, cards []*hand.Card) {
+	switch r {
+	case Front:
+		b.Front = append(b.Front, cards...)
+	case Middle:
+		b.Middle = append(b.Middle, cards...)
+	case Back:
+		b.Back = append(b.Back, cards...)
+	}
+}
+
+// the rank indexes of hand.Card.Index divided by four
+const (
+	sixes  = 4
+	queens = 10
+)
+
+var (
+	middleRoyalties = map[hand.Ranking]int{
+		hand.ThreeOfAKind:  2,
+		hand.Straight:      4,
+		hand.Flush:         8,
+		hand.FullHouse:     12,
+		hand.FourOfAKind:   20,
+		hand.StraightFlush: 30,
+		hand.RoyalFlush:    50,
+	}
+
+	backRoyalties = map[hand.Ranking]int{
+		hand.Straight:      2,
+		hand.Flush:         4,
+		hand.FullHouse:     6,
+		hand.FourOfAKind:   10,
+		hand.StraightFlush: 15,
+		hand.RoyalFlush:    25,
+	}
+)
+
+// Score returns the points board a wins from board b, or a negative
+// number if a loses points to b.  Each row won scores a point and winning
+// all three scores three more, so a scoop is worth six.  A fouled board
+// loses every row to a board that isn't fouled and two fouled boards
+// score nothing.  The difference in royalties is added.
+func Score(a, b *Board) int {
+	aFouled, bFouled := a.Fouled(), b.Fouled()
+	switch {
+	case aFouled && bFouled:
+		return 0
+	case aFouled:
+		return -6 - b.Royalties()
+	case bFouled:
+		return 6 + a.Royalties()
+	}
+
+	points, won, lost := 0, 0, 0
+	for _, r := range Rows() {
+		switch c := a.Hand(r).CompareTo(b.Hand(r)); {
+		case c > 0:
+			won++
+		case c < 0:
+			lost++
+		}
+	}
+	points = won - lost
+	if won == 3 {
+		points += 3
+	} else if lost == 3 {
+		points -= 3
+	}
+	return points + a.Royalties() - b.Royalties()
+}
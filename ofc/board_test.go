@@ -0,0 +1,117 @@
+package ofc_test
+
+import (
+	"testing"
+
+	. "github.com/rolends1986/poker/ofc"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+func board(front, middle, back []string) *Board {
+	return &Board{
+		Front:  pokertest.Cards(front...),
+		Middle: pokertest.Cards(middle...),
+		Back:   pokertest.Cards(back...),
+	}
+}
+
+func TestFouled(t *testing.T) {
+	t.Parallel()
+	foulTests := []struct {
+		Board  *Board
+		Fouled bool
+	}{
+		{board([]string{"2s", "3d", "4h"}, []string{"Ks", "Kd", "5c", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "9c"}), false},
+		{board([]string{"Qs", "Qd", "4h"}, []string{"Js", "Jd", "5c", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "9c"}), true},
+		{board([]string{"2s", "3d", "4h"}, []string{"As", "Ad", "Ah", "8c", "9c"}, []string{"Ks", "Kd", "5c", "6c", "7h"}), true},
+		{board([]string{"Ks", "Kd", "Qh"}, []string{"Kh", "Kc", "Qs", "3d", "2c"}, []string{"As", "Ad", "Ah", "8c", "9c"}), false},
+		{board([]string{"Qs", "Qd", "4h"}, []string{"Js", "Jd"}, []string{"As"}), false},
+	}
+	for _, test := range foulTests {
+		if fouled := test.Board.Fouled(); fouled != test.Fouled {
+			t.Errorf("%v Fouled() = %t; want %t", test.Board, fouled, test.Fouled)
+		}
+	}
+}
+
+func TestRoyalties(t *testing.T) {
+	t.Parallel()
+	royaltyTests := []struct {
+		Board     *Board
+		Rows      []int
+		Royalties int
+	}{
+		{
+			board([]string{"6s", "6d", "4h"}, []string{"Ks", "Kd", "Kh", "6c", "7h"}, []string{"9s", "Ts", "Js", "Qs", "Ks"}),
+			[]int{1, 2, 15},
+			18,
+		},
+		{
+			board([]string{"As", "Ad", "Ah"}, []string{"2s", "2d", "2h", "2c", "7h"}, []string{"Ts", "Js", "Qs", "Ks", "Ac"}),
+			[]int{22, 20, 2},
+			0, // fouled
+		},
+		{
+			board([]string{"5s", "5d", "Ah"}, []string{"2s", "4s", "6s", "8s", "Ts"}, []string{"3h", "3d", "3c", "Jh", "Jc"}),
+			[]int{0, 8, 6},
+			14,
+		},
+	}
+	for _, test := range royaltyTests {
+		for i, r := range Rows() {
+			if got := test.Board.RowRoyalty(r); got != test.Rows[i] {
+				t.Errorf("%v RowRoyalty(%v) = %d; want %d", test.Board, r, got, test.Rows[i])
+			}
+		}
+		if got := test.Board.Royalties(); got != test.Royalties {
+			t.Errorf("%v Royalties() = %d; want %d", test.Board, got, test.Royalties)
+		}
+	}
+}
+
+func TestFantasyland(t *testing.T) {
+	t.Parallel()
+	fantasylandTests := []struct {
+		Board *Board
+		Earns bool
+		Stays bool
+	}{
+		{board([]string{"Qs", "Qd", "4h"}, []string{"Ks", "Kd", "5c", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "9c"}), true, false},
+		{board([]string{"Js", "Jd", "4h"}, []string{"Ks", "Kd", "5c", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "9c"}), false, false},
+		{board([]string{"4s", "4d", "4h"}, []string{"Ks", "Kd", "Kc", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "8d"}), true, true},
+		{board([]string{"2s", "3d", "4h"}, []string{"Ks", "Kd", "Kc", "6c", "6h"}, []string{"As", "Ad", "Ah", "8c", "8d"}), false, true},
+		{board([]string{"Ks", "Kd", "4h"}, []string{"Qs", "Qd", "5c", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "9c"}), false, false},
+	}
+	for _, test := range fantasylandTests {
+		if got := test.Board.Fantasyland(); got != test.Earns {
+			t.Errorf("%v Fantasyland() = %t; want %t", test.Board, got, test.Earns)
+		}
+		if got := test.Board.StaysInFantasyland(); got != test.Stays {
+			t.Errorf("%v StaysInFantasyland() = %t; want %t", test.Board, got, test.Stays)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+	weak := board([]string{"2s", "3d", "5h"}, []string{"7s", "7d", "8c", "9c", "Jh"}, []string{"Ts", "Td", "Tc", "2c", "4c"})
+	strong := board([]string{"6s", "6d", "4h"}, []string{"Ks", "Kd", "5c", "6c", "7h"}, []string{"As", "Ad", "Ah", "8c", "9c"})
+	split := board([]string{"Qs", "Jd", "4h"}, []string{"2h", "2d", "5d", "3c", "7c"}, []string{"As", "Kd", "Qh", "Jc", "Tc"})
+	fouled := board([]string{"As", "Ad", "4h"}, []string{"Ks", "Kd", "5c", "6c", "7h"}, []string{"Qs", "Qd", "2h", "8c", "9c"})
+	scoreTests := []struct {
+		A, B  *Board
+		Score int
+	}{
+		{strong, weak, 7},
+		{weak, strong, -7},
+		{split, weak, 1 + 2},
+		{fouled, weak, -6},
+		{strong, fouled, 7},
+		{fouled, fouled, 0},
+	}
+	for _, test := range scoreTests {
+		if got := Score(test.A, test.B); got != test.Score {
+			t.Errorf("Score(%v, %v) = %d; want %d", test.A, test.B, got, test.Score)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+/*
+Package ofc implements open face Chinese poker.
+
+Each player places thirteen cards face up into a three card front row and
+five card middle and back rows.  The back must be at least as strong as
+the middle and the middle at least as strong as the front, or the hand is
+fouled.  Hands are scored against each other row by row with 1-6 scoring
+and royalties, and a strong enough front earns Fantasyland, where the
+next hand is dealt all at once.  Regular and Pineapple OFC are supported.
+*/
+package ofc
@@ -0,0 +1,675 @@
+package ofc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rolends1986/poker/hand"
+)
+
+var (
+	// ErrInvalidSeat errors occur when a player attempts to sit at a
+	// table in a seat that is invalid.
+	ErrInvalidSeat = errors.New("ofc: player attempted sitting in invalid seat")
+
+	// ErrSeatOccupied errors occur when a player attempts to sit at a
+	// table in a seat that is already occupied.
+	ErrSeatOccupied = errors.New("ofc: player attempted sitting in occupied seat")
+
+	// ErrAlreadySeated errors occur when a player attempts to sit at a
+	// table at which the player is already seated.
+	ErrAlreadySeated = errors.New("ofc: player attempted sitting when already seated")
+
+	// ErrInsufficientPlayers errors occur when the table's Next() method
+	// can't start a new hand because of insufficient players.
+	ErrInsufficientPlayers = errors.New("ofc: insufficent players for call to table's Next() method")
+
+	// ErrInvalidPlacement errors occur when a player's placement doesn't
+	// place each dealt card exactly once, overfills a row or discards the
+	// wrong number of cards.
+	ErrInvalidPlacement = errors.New("ofc: player attempted invalid placement")
+)
+
+// A Variant is a version of open face Chinese poker.
+type Variant string
+
+const (
+	// Regular deals five cards and then one card at a time.  Fantasyland
+	// deals all thirteen cards at once.
+	Regular Variant = "Regular"
+
+	// Pineapple deals five cards and then three cards at a time, of which
+	// two are placed and one is discarded.  Fantasyland deals fourteen
+	// cards at once, of which one is discarded.
+	Pineapple Variant = "Pineapple"
+)
+
+// Variants returns all Variants.
+func Variants() []Variant {
+	return []Variant{Regular, Pineapple}
+}
+
+// MaxSeats returns the most players the deck can deal.
+func (v Variant) MaxSeats() int {
+	if v == Pineapple {
+		return 3
+	}
+	return 4
+}
+
+// numOfRounds returns the number of rounds of dealing.
+func (v Variant) numOfRounds() int {
+	if v == Pineapple {
+		return 5
+	}
+	return 9
+}
+
+// deal returns the number of cards dealt and discarded in the round, or
+// all at once in Fantasyland.
+func (v Variant) deal(r int, fantasyland bool) (cards, discards int) {
+	switch {
+	case fantasyland && v == Pineapple:
+		return 14, 1
+	case fantasyland:
+		return 13, 0
+	case r == 0:
+		return 5, 0
+	case v == Pineapple:
+		return 3, 1
+	}
+	return 1, 0
+}
+
+// Config are the configurations for creating a table.
+type Config struct {
+	// Variant is the version of open face Chinese poker.
+	Variant Variant `json:"variant" bson:"variant"`
+
+	// NumOfSeats is the number of seats available for the table.
+	NumOfSeats int `json:"numOfSeats" bson:"numOfSeats"`
+
+	// PointValue is the number of chips each point is worth.
+	PointValue int `json:"pointValue" bson:"pointValue"`
+}
+
+// Player represents a player at a table.
+type Player interface {
+	// ID returns the unique identifier of the player.
+	ID() int64
+
+	// Place returns where the player places the cards dealt and which
+	// cards are discarded.  This method will block table's Next()
+	// function until input is recieved.  If timeout is true the table
+	// places the cards for the player.
+	Place(dealt []*hand.Card) (p Placement, timeout bool)
+}
+
+// A Placement is where a player places the cards dealt in a round.
+type Placement struct {
+	Front    []*hand.Card `json:"front,omitempty" bson:"front,omitempty"`
+	Middle   []*hand.Card `json:"middle,omitempty" bson:"middle,omitempty"`
+	Back     []*hand.Card `json:"back,omitempty" bson:"back,omitempty"`
+	Discards []*hand.Card `json:"discards,omitempty" bson:"discards,omitempty"`
+}
+
+// Row returns the cards placed in the row.
+func (p Placement) Row(r Row) []*hand.Card {
+	switch r {
+	case Front:
+		return p.Front
+	case Middle:
+		return p.Middle
+	case Back:
+		return p.Back
+	}
+	return nil
+}
+
+// PlayerState is the state of a player at a table.
+type PlayerState struct {
+	player          Player
+	chips           int
+	inHand          bool
+	board           *Board
+	dealt           []*hand.Card
+	discards        []*hand.Card
+	fantasyland     bool
+	nextFantasyland bool
+	stand           bool
+}
+
+// Player returns the player.
+func (state *PlayerState) Player() Player {
+	return state.player
+}
+
+// Chips returns the number of chips the player has in his or her stack.
+func (state *PlayerState) Chips() int {
+	return state.chips
+}
+
+// Board returns a copy of the cards the player has placed.
+func (state *PlayerState) Board() *Board {
+	b := &Board{}
+	for _, r := range Rows() {
+		b.add(r, state.board.Row(r))
+	}
+	return b
+}
+
+// Dealt returns the cards dealt to the player that haven't been placed.
+func (state *PlayerState) Dealt() []*hand.Card {
+	return append([]*hand.Card{}, state.dealt...)
+}
+
+// Discards returns the cards the player has discarded in the hand.
+func (state *PlayerState) Discards() []*hand.Card {
+	return append([]*hand.Card{}, state.discards...)
+}
+
+// InHand returns whether the player is dealt in the current or last
+// hand.  Players without chips sit out.
+func (state *PlayerState) InHand() bool {
+	return state.inHand
+}
+
+// Standing returns whether the player stood up during the hand and
+// leaves the table when it is over.
+func (state *PlayerState) Standing() bool {
+	return state.stand
+}
+
+// Fantasyland returns whether the player is playing the hand in
+// Fantasyland.
+func (state *PlayerState) Fantasyland() bool {
+	return state.fantasyland
+}
+
+// String returns a string useful for debugging.
+func (state *PlayerState) String() string {
+	const format = "{Player: %v, Chips: %d, Board: %v, Dealt: %v, Fantasyland: %t}"
+	return fmt.Sprintf(format, state.player.ID(), state.chips, state.board, state.dealt, state.fantasyland)
+}
+
+// A Result is a player's points and chips won or lost in a hand.
+type Result struct {
+	// Board is the player's complete board.
+	Board *Board `json:"board"`
+
+	// Points are the points won from every other player, or lost if
+	// negative.
+	Points int `json:"points"`
+
+	// Chips are the chips won, or lost if negative.
+	Chips int `json:"chips"`
+
+	// Royalties are the bonus points of the board.
+	Royalties int `json:"royalties"`
+
+	// Fouled is true if the board was fouled.
+	Fouled bool `json:"fouled"`
+
+	// Fantasyland is true if the player plays the next hand in
+	// Fantasyland.
+	Fantasyland bool `json:"fantasyland"`
+}
+
+// String returns a string useful for debugging.
+func (r *Result) String() string {
+	const format = "%d points for %d chips with %d royalties, fouled %t, fantasyland %t"
+	return fmt.Sprintf(format, r.Points, r.Chips, r.Royalties, r.Fouled, r.Fantasyland)
+}
+
+// Table represents an open face Chinese poker table and dealer.  A table
+// manages the game state and all player interactions at the table.
+type Table struct {
+	opts         Config
+	dealer       hand.Dealer
+	deck         *hand.Deck
+	button       int
+	action       int
+	round        int
+	players      map[int]*PlayerState
+	startedHand  bool
+	sync.RWMutex `bson:"-" json:"-"`
+}
+
+// New creates a new table with the options and deck provided.  To start
+// playing hands, at least two players must be seated and the Next()
+// function must be called.  If the number of seats is invalid for the
+// Variant specified or the Variant is invalid New panics.
+func New(opts Config, dealer hand.Dealer) *Table {
+	if opts.Variant != Regular && opts.Variant != Pineapple {
+		panic(fmt.Sprintf("ofc: %q isn't a valid variant", opts.Variant))
+	}
+	if opts.NumOfSeats > opts.Variant.MaxSeats() {
+		format := "ofc: %s has a maximum of %d seats but attempted %d"
+		panic(fmt.Sprintf(format, opts.Variant, opts.Variant.MaxSeats(), opts.NumOfSeats))
+	}
+	return &Table{
+		opts:    opts,
+		dealer:  dealer,
+		deck:    &hand.Deck{Cards: []*hand.Card{}},
+		action:  -1,
+		players: map[int]*PlayerState{},
+	}
+}
+
+// Action returns the seat that the action is currently on.  If no seat
+// has the action then -1 is returned.
+func (t *Table) Action() int {
+	return t.action
+}
+
+// Button returns the seat that the button is currently on.
+func (t *Table) Button() int {
+	return t.button
+}
+
+// Round returns the current round of dealing.
+func (t *Table) Round() int {
+	return t.round
+}
+
+// Opts returns the table's configuration.
+func (t *Table) Opts() Config {
+	return t.opts
+}
+
+// StartedHand returns whether a hand is in progress.
+func (t *Table) StartedHand() bool {
+	return t.startedHand
+}
+
+// CurrentPlayer returns the player the action is currently on.  If no
+// player is current then it returns nil.
+func (t *Table) CurrentPlayer() *PlayerState {
+	t.RLock()
+	defer t.RUnlock()
+	return t.players[t.action]
+}
+
+// Players returns a mapping of seats to player states.  Empty seats are
+// not included.
+func (t *Table) Players() map[int]*PlayerState {
+	t.RLock()
+	defer t.RUnlock()
+	players := map[int]*PlayerState{}
+	for seat, p := range t.players {
+		players[seat] = p
+	}
+	return players
+}
+
+// Player returns the state of the player in the seat or nil.
+func (t *Table) Player(seat int) *PlayerState {
+	t.RLock()
+	defer t.RUnlock()
+	return t.players[seat]
+}
+
+// View returns a view of the table that only contains information
+// privileged to the given player.  Other players' dealt cards and
+// discards are hidden, and so are the boards of other players in
+// Fantasyland until the hand is over.
+func (t *Table) View(p Player) *Table {
+	t.RLock()
+	defer t.RUnlock()
+	players := map[int]*PlayerState{}
+	for seat, player := range t.players {
+		if p.ID() == player.player.ID() {
+			players[seat] = player
+			continue
+		}
+		board := player.board
+		if t.startedHand && player.fantasyland {
+			board = &Board{}
+		}
+		players[seat] = &PlayerState{
+			player:          player.player,
+			chips:           player.chips,
+			inHand:          player.inHand,
+			board:           board,
+			fantasyland:     player.fantasyland,
+			nextFantasyland: player.nextFantasyland,
+			stand:           player.stand,
+		}
+	}
+	return &Table{
+		opts:        t.opts,
+		deck:        &hand.Deck{Cards: []*hand.Card{}},
+		button:      t.button,
+		action:      t.action,
+		round:       t.round,
+		players:     players,
+		startedHand: t.startedHand,
+	}
+}
+
+// Sit sits the player at the table with the given amount of chips.  An
+// error is return if the seat is invalid, the player is already seated
+// or the seat is already occupied.
+func (t *Table) Sit(p Player, seat, chips int) error {
+	t.Lock()
+	defer t.Unlock()
+	if seat < 0 || seat >= t.opts.NumOfSeats {
+		return ErrInvalidSeat
+	}
+	for _, pl := range t.players {
+		if pl.player.ID() == p.ID() {
+			return ErrAlreadySeated
+		}
+	}
+	if _, occupied := t.players[seat]; occupied {
+		return ErrSeatOccupied
+	}
+	t.players[seat] = &PlayerState{player: p, chips: chips, board: &Board{}}
+	return nil
+}
+
+// Stand removes the player from the table.  A player in the hand stays
+// until it is scored, and the table places the rest of his or her cards
+// as if the player timed out.  If the player isn't seated the command is
+// ignored.
+func (t *Table) Stand(p Player) {
+	t.Lock()
+	defer t.Unlock()
+	for seat, pl := range t.players {
+		if pl.player.ID() == p.ID() {
+			if t.startedHand && pl.inHand {
+				pl.stand = true
+				return
+			}
+			delete(t.players, seat)
+			return
+		}
+	}
+}
+
+// Next is the iterator function of the table.  Next deals each player
+// his or her cards in turn and calls the player's Place() method.  New
+// hands are started automatically if there are two or more players with
+// chips.  When every board is complete the hand is scored and the
+// results are returned as a map of seats to results, otherwise results
+// are nil.  err is nil unless there are insufficient players to start the
+// next hand or a player's placement is invalid, in which case the player
+// is asked again.  done indicates that the table can not continue.
+func (t *Table) Next() (results map[int]*Result, done bool, err error) {
+	if !t.startedHand {
+		if !t.hasNextHand() {
+			return nil, true, ErrInsufficientPlayers
+		}
+		t.setUpHand()
+		t.startedHand = true
+		return nil, false, nil
+	}
+
+	current := t.CurrentPlayer()
+	p, timeout := Placement{}, true
+	if !current.stand {
+		p, timeout = current.player.Place(current.Dealt())
+	}
+	if timeout {
+		p = t.defaultPlacement(current)
+	}
+	if err := t.place(current, p); err != nil {
+		return nil, false, err
+	}
+
+	t.action = t.nextSeat(t.action + 1)
+	for t.action == -1 {
+		t.round++
+		if t.round == t.opts.Variant.numOfRounds() {
+			results = t.settle()
+			t.startedHand = false
+			t.removeStanding()
+			return results, false, nil
+		}
+		t.setUpRound()
+	}
+	return nil, false, nil
+}
+
+// removeStanding removes the players who stood up during the hand.
+func (t *Table) removeStanding() {
+	t.Lock()
+	defer t.Unlock()
+	for seat, player := range t.players {
+		if player.stand {
+			delete(t.players, seat)
+		}
+	}
+}
+
+func (t *Table) setUpHand() {
+	t.deck = t.dealer.Deck()
+	for _, card := range t.deck.Cards {
+		if card.IsJoker() {
+			panic("ofc: dealer dealt a joker, which tables don't support")
+		}
+	}
+	t.round = 0
+	t.button = t.nextPlayer(t.button + 1)
+	t.Lock()
+	for _, player := range t.players {
+		player.inHand = player.chips > 0
+		player.board = &Board{}
+		player.dealt = nil
+		player.discards = nil
+		player.fantasyland = player.inHand && player.nextFantasyland
+	}
+	t.Unlock()
+	t.setUpRound()
+}
+
+// setUpRound deals each player's cards for the round starting after the
+// button and gives the action to the first player with cards to place.
+// Players in Fantasyland are dealt every card in the first round.
+func (t *Table) setUpRound() {
+	t.Lock()
+	for count := 0; count < t.opts.NumOfSeats; count++ {
+		player, ok := t.players[(t.button+1+count)%t.opts.NumOfSeats]
+		if !ok || !player.inHand || (t.round > 0 && player.fantasyland) {
+			continue
+		}
+		n, _ := t.opts.Variant.deal(t.round, player.fantasyland)
+		player.dealt = t.deck.PopMulti(n)
+	}
+	t.Unlock()
+	t.action = t.nextSeat(t.button + 1)
+}
+
+// place validates the placement of the player's dealt cards and places
+// them on the board.
+func (t *Table) place(state *PlayerState, p Placement) error {
+	_, discards := t.opts.Variant.deal(t.round, state.fantasyland)
+	if len(p.Discards) != discards {
+		return ErrInvalidPlacement
+	}
+
+	dealt := hand.NewCardSet(state.dealt...)
+	placed := append(append([]*hand.Card{}, p.Discards...), p.Front...)
+	placed = append(append(placed, p.Middle...), p.Back...)
+	if len(placed) != len(state.dealt) {
+		return ErrInvalidPlacement
+	}
+	for _, card := range placed {
+		if card == nil || !dealt.Contains(card) {
+			return ErrInvalidPlacement
+		}
+		dealt = dealt.Remove(card)
+	}
+	for _, r := range Rows() {
+		if len(state.board.Row(r))+len(p.Row(r)) > r.Size() {
+			return ErrInvalidPlacement
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	for _, r := range Rows() {
+		state.board.add(r, p.Row(r))
+	}
+	state.discards = append(state.discards, p.Discards...)
+	state.dealt = nil
+	return nil
+}
+
+// defaultPlacement places the dealt cards of a player who times out from
+// the back row to the front and discards the last cards.
+func (t *Table) defaultPlacement(state *PlayerState) Placement {
+	_, discards := t.opts.Variant.deal(t.round, state.fantasyland)
+	cards := state.Dealt()
+	p := Placement{Discards: cards[len(cards)-discards:]}
+	cards = cards[:len(cards)-discards]
+	for _, r := range []Row{Back, Middle, Front} {
+		n := r.Size() - len(state.board.Row(r))
+		if n > len(cards) {
+			n = len(cards)
+		}
+		switch r {
+		case Back:
+			p.Back = cards[:n]
+		case Middle:
+			p.Middle = cards[:n]
+		case Front:
+			p.Front = cards[:n]
+		}
+		cards = cards[n:]
+	}
+	return p
+}
+
+// settle scores every pair of boards, pays out the points in chips and
+// decides who plays the next hand in Fantasyland.
+func (t *Table) settle() map[int]*Result {
+	t.Lock()
+	defer t.Unlock()
+
+	seats := []int{}
+	for seat, player := range t.players {
+		if player.inHand {
+			seats = append(seats, seat)
+		}
+	}
+	sort.Ints(seats)
+
+	points := map[int]int{}
+	chips := map[int]int{}
+	for i, seat := range seats {
+		chips[seat] = t.players[seat].chips
+		for _, other := range seats[i+1:] {
+			s := Score(t.players[seat].board, t.players[other].board)
+			points[seat] += s
+			points[other] -= s
+		}
+	}
+
+	won := settle(seats, points, chips, t.opts.PointValue)
+	results := map[int]*Result{}
+	for _, seat := range seats {
+		player := t.players[seat]
+		board := player.board
+		if player.fantasyland {
+			player.nextFantasyland = board.StaysInFantasyland()
+		} else {
+			player.nextFantasyland = board.Fantasyland()
+		}
+		player.chips += won[seat]
+		results[seat] = &Result{
+			Board:       board,
+			Points:      points[seat],
+			Chips:       won[seat],
+			Royalties:   board.Royalties(),
+			Fouled:      board.Fouled(),
+			Fantasyland: player.nextFantasyland,
+		}
+	}
+	t.action = -1
+	return results
+}
+
+// settle returns the chips won or lost for the points at the point value.
+// Players can't lose more chips than they have, so what the losers pay is
+// shared by the winners in proportion to their points, with the odd chips
+// going to the first winners by seat.
+func settle(seats []int, points, chips map[int]int, value int) map[int]int {
+	won := map[int]int{}
+	paid, winning := 0, 0
+	for _, seat := range seats {
+		switch p := points[seat]; {
+		case p < 0:
+			loss := -p * value
+			if loss > chips[seat] {
+				loss = chips[seat]
+			}
+			won[seat] = -loss
+			paid += loss
+		case p > 0:
+			winning += p
+		}
+	}
+	if winning == 0 {
+		return won
+	}
+
+	shared := 0
+	for _, seat := range seats {
+		if p := points[seat]; p > 0 {
+			won[seat] = paid * p / winning
+			shared += won[seat]
+		}
+	}
+	for _, seat := range seats {
+		if shared == paid {
+			break
+		}
+		if points[seat] > 0 {
+			won[seat]++
+			shared++
+		}
+	}
+	return won
+}
+
+// nextSeat returns the next seat from seat of a player with cards to
+// place, or -1 if there are none.
+func (t *Table) nextSeat(seat int) int {
+	t.RLock()
+	defer t.RUnlock()
+	for count := 0; count < t.opts.NumOfSeats; count++ {
+		s := (seat + count) % t.opts.NumOfSeats
+		if p, ok := t.players[s]; ok && len(p.dealt) > 0 {
+			return s
+		}
+	}
+	return -1
+}
+
+// nextPlayer returns the next occupied seat from seat.
+func (t *Table) nextPlayer(seat int) int {
+	t.RLock()
+	defer t.RUnlock()
+	for count := 0; count < t.opts.NumOfSeats; count++ {
+		s := (seat + count) % t.opts.NumOfSeats
+		if _, ok := t.players[s]; ok {
+			return s
+		}
+	}
+	return -1
+}
+
+func (t *Table) hasNextHand() bool {
+	t.RLock()
+	defer t.RUnlock()
+	count := 0
+	for _, player := range t.players {
+		if player.chips > 0 {
+			count++
+		}
+	}
+	return count > 1
+}
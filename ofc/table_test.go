@@ -0,0 +1,241 @@
+package ofc_test
+
+import (
+	"testing"
+
+	"github.com/rolends1986/poker/hand"
+	. "github.com/rolends1986/poker/ofc"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+func TestHand(t *testing.T) {
+	t.Parallel()
+	cards := pokertest.Cards(
+		"As", "Ad", "Ah", "8c", "9c",
+		"Ts", "Td", "Tc", "2c", "4c",
+		"Ks", "7s", "Kd", "7d", "5c", "8d", "6c", "9d", "7h", "Jh",
+		"Qs", "2s", "Qd", "3d", "4h", "5h",
+	)
+	opts := Config{Variant: Regular, NumOfSeats: 2, PointValue: 10}
+	tbl := New(opts, pokertest.Dealer(cards))
+	p1 := &testPlayer{id: 1}
+	p2 := &testPlayer{id: 2}
+	if err := tbl.Sit(p1, 0, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100); err != nil {
+		t.Fatal(err)
+	}
+
+	// overfills the front so the placement is asked again
+	p1.placements = []Placement{{Front: cards[:5]}}
+
+	if _, _, err := tbl.Next(); err != nil {
+		t.Fatal(err)
+	}
+	view := tbl.View(p1)
+	if dealt := view.Player(0).Dealt(); len(dealt) != 5 {
+		t.Errorf("view of own dealt cards = %v; want 5 cards", dealt)
+	}
+	if dealt := view.Player(1).Dealt(); len(dealt) != 0 {
+		t.Errorf("view of other dealt cards = %v; want none", dealt)
+	}
+	if _, _, err := tbl.Next(); err != ErrInvalidPlacement {
+		t.Fatalf("Next() error = %v; want %v", err, ErrInvalidPlacement)
+	}
+
+	var results map[int]*Result
+	for results == nil {
+		var err error
+		if results, _, err = tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// queens in the front are worth seven and the loss is capped at
+	// the loser's stack
+	if r := results[0]; r.Points != 13 || r.Chips != 100 || r.Royalties != 7 || !r.Fantasyland {
+		t.Errorf("results[0] = %v; want 13 points for 100 chips with 7 royalties and fantasyland", r)
+	}
+	if r := results[1]; r.Points != -13 || r.Chips != -100 || r.Fantasyland {
+		t.Errorf("results[1] = %v; want -13 points for -100 chips", r)
+	}
+	if chips := tbl.Player(0).Chips(); chips != 200 {
+		t.Errorf("seat 0 chips = %d; want 200", chips)
+	}
+
+	// the next hand can't start with one player holding every chip
+	if _, done, err := tbl.Next(); !done || err != ErrInsufficientPlayers {
+		t.Errorf("Next() = %t, %v; want done and %v", done, err, ErrInsufficientPlayers)
+	}
+}
+
+func TestFantasylandHand(t *testing.T) {
+	t.Parallel()
+	cards := pokertest.Cards(
+		"As", "Ad", "Ah", "8c", "9c",
+		"Ts", "Td", "Tc", "2c", "4c",
+		"Ks", "7s", "Kd", "7d", "5c", "8d", "6c", "9d", "7h", "Jh",
+		"Qs", "2s", "Qd", "3d", "4h", "5h",
+	)
+	opts := Config{Variant: Regular, NumOfSeats: 3, PointValue: 1}
+	tbl := New(opts, pokertest.Dealer(cards))
+	p1 := &testPlayer{id: 1}
+	p2 := &testPlayer{id: 2}
+	if err := tbl.Sit(p1, 0, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100); err != nil {
+		t.Fatal(err)
+	}
+	playHand(t, tbl)
+
+	if _, _, err := tbl.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if p := tbl.Player(0); !p.Fantasyland() || len(p.Dealt()) != 13 {
+		t.Errorf("seat 0 = %v; want 13 cards dealt in fantasyland", p)
+	}
+	if board := tbl.View(p2).Player(0).Board(); board.Len() != 0 {
+		t.Errorf("view of fantasyland board = %v; want it hidden", board)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	t.Parallel()
+	for _, variant := range Variants() {
+		opts := Config{Variant: variant, NumOfSeats: variant.MaxSeats(), PointValue: 1}
+		tbl := New(opts, hand.NewDealer())
+		for seat := 0; seat < opts.NumOfSeats; seat++ {
+			if err := tbl.Sit(&testPlayer{id: int64(seat)}, seat, 1000); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for i := 0; i < 10; i++ {
+			results := playHand(t, tbl)
+			sum := 0
+			for seat, r := range results {
+				sum += r.Chips
+				if !r.Board.Complete() {
+					t.Errorf("%s seat %d board %v isn't complete", variant, seat, r.Board)
+				}
+				state := tbl.Player(seat)
+				discards := 0
+				if variant == Pineapple {
+					discards = 4
+					if state.Fantasyland() {
+						discards = 1
+					}
+				}
+				if len(state.Discards()) != discards {
+					t.Errorf("%s seat %d discards = %v; want %d", variant, seat, state.Discards(), discards)
+				}
+			}
+			if sum != 0 {
+				t.Errorf("%s results %v sum to %d chips; want 0", variant, results, sum)
+			}
+		}
+	}
+}
+
+func TestStandDuringHand(t *testing.T) {
+	t.Parallel()
+	tbl := New(Config{Variant: Regular, NumOfSeats: 2, PointValue: 1}, hand.NewDealer())
+	p1 := &testPlayer{id: 1}
+	p2 := &testPlayer{id: 2}
+	if err := tbl.Sit(p1, 0, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tbl.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the player with the action stands up
+	seat := tbl.Action()
+	current := tbl.CurrentPlayer().Player().(*testPlayer)
+	current.placements = []Placement{{}}
+	tbl.Stand(current)
+	if p := tbl.Player(seat); p == nil || !p.Standing() {
+		t.Fatalf("seat %d = %v; want the player standing until the hand is over", seat, p)
+	}
+
+	results := playHand(t, tbl)
+	if r, ok := results[seat]; !ok || !r.Board.Complete() {
+		t.Fatalf("seat %d result = %v; want a complete board", seat, r)
+	}
+	if len(current.placements) != 1 {
+		t.Error("a standing player shouldn't be asked to place cards")
+	}
+	if p := tbl.Player(seat); p != nil {
+		t.Errorf("seat %d = %v; want the player gone after the hand", seat, p)
+	}
+}
+
+func TestJokerDeckPanics(t *testing.T) {
+	t.Parallel()
+	tbl := New(Config{Variant: Regular, NumOfSeats: 2}, hand.NewDealer(hand.Jokers(1)))
+	for seat := 0; seat < 2; seat++ {
+		if err := tbl.Sit(&testPlayer{id: int64(seat)}, seat, 100); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Next() should panic when the dealer deals a joker")
+		}
+	}()
+	tbl.Next()
+}
+
+func TestNewPanics(t *testing.T) {
+	t.Parallel()
+	for _, opts := range []Config{
+		{Variant: Regular, NumOfSeats: 5},
+		{Variant: Pineapple, NumOfSeats: 4},
+		{Variant: "Progressive", NumOfSeats: 2},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New(%v) should panic", opts)
+				}
+			}()
+			New(opts, hand.NewDealer())
+		}()
+	}
+}
+
+// playHand plays a hand to its results.
+func playHand(t *testing.T, tbl *Table) map[int]*Result {
+	for {
+		results, _, err := tbl.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results != nil {
+			return results
+		}
+	}
+}
+
+// testPlayer places the queued placements and then times out.
+type testPlayer struct {
+	id         int64
+	placements []Placement
+}
+
+func (p *testPlayer) ID() int64 {
+	return p.id
+}
+
+func (p *testPlayer) Place(dealt []*hand.Card) (Placement, bool) {
+	if len(p.placements) == 0 {
+		return Placement{}, true
+	}
+	placement := p.placements[0]
+	p.placements = p.placements[1:]
+	return placement, false
+}
@@ -5,7 +5,8 @@ import "github.com/rolends1986/poker/hand"
 // Cards takes a list of strings that have the format "4s", "Tc",
 // "Ah" instead of the hand.Card String() format "4♠", "T♣", "A♥"
 // for ease of testing.  If a string is invalid Cards panics,
-// otherwise it returns a list of the corresponding cards.
+// otherwise it returns a list of the corresponding cards.  Use
+// hand.ParseCards to parse cards without panicking.
 func Cards(list ...string) []*hand.Card {
 	cards := []*hand.Card{}
 	for _, s := range list {
@@ -36,50 +37,14 @@ func (d deck) Deck() *hand.Deck {
 	return &hand.Deck{Cards: cards}
 }
 
-func card(s string) *hand.Card {
-	if len(s) != 2 {
-		panic("pokertest: card string must be two characters")
-	}
-
-	rank, ok := rankMap[s[:1]]
-	if !ok {
-		panic("pokertest: rank not found")
-	}
-
-	suit, ok := suitMap[s[1:]]
-	if !ok {
-		panic("pokertest: suit not found")
-	}
+// Shuffle implements hand.Shuffler and leaves the cards in order so tests
+// that reshuffle a stub stay predictable.
+func (d deck) Shuffle(*hand.Deck) {}
 
-	for _, c := range hand.Cards() {
-		if rank == c.Rank() && suit == c.Suit() {
-			return c
-		}
+func card(s string) *hand.Card {
+	c, err := hand.ParseCard(s)
+	if err != nil {
+		panic("pokertest: " + err.Error())
 	}
-	panic("pokertest: card not found")
+	return c
 }
-
-var (
-	rankMap = map[string]hand.Rank{
-		"A": hand.Ace,
-		"K": hand.King,
-		"Q": hand.Queen,
-		"J": hand.Jack,
-		"T": hand.Ten,
-		"9": hand.Nine,
-		"8": hand.Eight,
-		"7": hand.Seven,
-		"6": hand.Six,
-		"5": hand.Five,
-		"4": hand.Four,
-		"3": hand.Three,
-		"2": hand.Two,
-	}
-
-	suitMap = map[string]hand.Suit{
-		"s": hand.Spades,
-		"h": hand.Hearts,
-		"d": hand.Diamonds,
-		"c": hand.Clubs,
-	}
-)
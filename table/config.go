@@ -1,5 +1,7 @@
 package table
 
+import "github.com/rolends1986/poker/hand"
+
 // An Action is an action a player can take in a hand.
 type Action string
 
@@ -28,6 +30,28 @@ const (
 	// 加注 - 把现有的注金抬高
 	Raise Action = "Raise"
 
+	// Draw discards any number of hole cards, including none, and
+	// replaces them from the deck in draw games.
+	// 换牌 - 弃掉部分手牌并补发同样数量的牌
+	Draw Action = "Draw"
+
+	// Discard discards hole cards without replacing them, such as one of
+	// the three hole cards in Pineapple.
+	// 弃牌 - 弃掉部分手牌且不补牌
+	Discard Action = "Discard"
+
+	// Run votes how many times to run the rest of the board when
+	// everyone left is all in.  The action's chips are the number of
+	// runs voted for.
+	// 发多次 - 全下后投票决定剩余公共牌发几次
+	Run Action = "Run"
+
+	// Insure buys insurance on the next board card when leading a pot
+	// with a player all in.  The action's chips are the premium, or zero
+	// to decline.
+	// 保险 - 领先玩家购买保险
+	Insure Action = "Insure"
+
 	// 牌局中玩家马上站起
 	Stand Action = "stand"
 )
@@ -56,6 +80,19 @@ type Player interface {
 	SaveAction(round int, playerAction PlayerAction)
 }
 
+// A Drawer is a Player of draw and discard games.  When a Drawer's Action
+// returns Draw or Discard, the table calls Discards for the hole cards to
+// discard.  Players that aren't Drawers, and Drawers that time out, make
+// the default discard: standing pat in draw games and keeping the best
+// hand in discard games such as Pineapple.
+type Drawer interface {
+	Player
+
+	// Discards returns the hole cards the player discards in the current
+	// draw or discard phase.
+	Discards() []*hand.Card
+}
+
 // RegisterPlayer stores the player implementation for json deserialization.
 func RegisterPlayer(p Player) {
 	registeredPlayer = p
@@ -111,4 +148,126 @@ type Config struct {
 
 	// NumOfSeats is the number of seats available for the table.
 	NumOfSeats int `json:"numOfSeats" bson:"numOfSeats"`
+
+	// AnteOnly deals hold'em games without blinds, as short deck hold'em
+	// often is.  Every player posts the ante, the player after the button
+	// acts first, or the button heads up, and the big bet is only the
+	// minimum bet.
+	AnteOnly bool `json:"anteOnly,omitempty" bson:"anteOnly,omitempty"`
+
+	// Qualifier is the highest low hand that can win the low half of a
+	// split pot.  The zero value is EightOrBetter.
+	Qualifier Qualifier `json:"qualifier,omitempty" bson:"qualifier,omitempty"`
+
+	// Boards is the number of boards dealt in hold'em and Omaha games.
+	// With two boards every pot is split in half between the best hands
+	// of each board, and then again between high and low in split pot
+	// games.  The zero value deals one board.
+	Boards int `json:"boards,omitempty" bson:"boards,omitempty"`
+
+	// MaxRuns is the most times the rest of the board can be run in
+	// hold'em and Omaha games when everyone left is all in before the
+	// river.  Each player left votes with the Run action and the board is
+	// run the fewest times voted for.  Zero or one always runs it once.
+	MaxRuns int `json:"maxRuns,omitempty" bson:"maxRuns,omitempty"`
+
+	// Insurance offers insurance in hold'em and Omaha games to the player
+	// leading the largest pot with a player all in, before the turn and
+	// the river.  The premium is paid from the chips the player has behind
+	// when it is bought, so a player all in can't buy insurance.  If an
+	// out hits but the insured player wins the pot all the same, the
+	// payout is bought back and the premium is lost.
+	Insurance bool `json:"insurance,omitempty" bson:"insurance,omitempty"`
+
+	// InsuranceOdds are the odds paid on insurance keyed by the number of
+	// outs.  Nil uses DefaultInsuranceOdds.
+	InsuranceOdds map[int]float64 `json:"insuranceOdds,omitempty" bson:"insuranceOdds,omitempty"`
+}
+
+// Qualifier is the requirement a low hand must meet to win the low half
+// of a split pot.
+type Qualifier string
+
+const (
+	// NoQualifier lets any low hand win the low half of the pot.
+	NoQualifier Qualifier = "None"
+
+	// SevenOrBetter requires a low hand of seven high or lower.
+	SevenOrBetter Qualifier = "7OrBetter"
+
+	// EightOrBetter requires a low hand of eight high or lower.
+	EightOrBetter Qualifier = "8OrBetter"
+
+	// NineOrBetter requires a low hand of nine high or lower.
+	NineOrBetter Qualifier = "9OrBetter"
+)
+
+// Qualifiers returns all Qualifiers.
+func Qualifiers() []Qualifier {
+	return []Qualifier{NoQualifier, SevenOrBetter, EightOrBetter, NineOrBetter}
+}
+
+// qualifierHands are the worst low hands that qualify.
+var qualifierHands = map[Qualifier]*hand.Hand{
+	SevenOrBetter: hand.New([]*hand.Card{
+		hand.SevenSpades,
+		hand.SixSpades,
+		hand.FiveSpades,
+		hand.FourSpades,
+		hand.ThreeSpades,
+	}, hand.AceToFiveLow),
+	EightOrBetter: hand.New([]*hand.Card{
+		hand.EightSpades,
+		hand.SevenSpades,
+		hand.SixSpades,
+		hand.FiveSpades,
+		hand.FourSpades,
+	}, hand.AceToFiveLow),
+	NineOrBetter: hand.New([]*hand.Card{
+		hand.NineSpades,
+		hand.EightSpades,
+		hand.SevenSpades,
+		hand.SixSpades,
+		hand.FiveSpades,
+	}, hand.AceToFiveLow),
+}
+
+// valid returns true if the qualifier is one of the Qualifiers or the
+// zero value.
+func (q Qualifier) valid() bool {
+	if q == "" {
+		return true
+	}
+	for _, qualifier := range Qualifiers() {
+		if q == qualifier {
+			return true
+		}
+	}
+	return false
+}
+
+// orDefault returns EightOrBetter for the zero value.
+func (q Qualifier) orDefault() Qualifier {
+	if q == "" {
+		return EightOrBetter
+	}
+	return q
+}
+
+// equityOption returns the hand.CalcEquity option for the qualifier.
+func (q Qualifier) equityOption() func(*hand.EquityConfig) {
+	q = q.orDefault()
+	if q == NoQualifier {
+		return hand.EquityQualifier(nil)
+	}
+	return hand.EquityQualifier(qualifierHands[q])
+}
+
+// qualifies returns true if the ace to five low hand meets the qualifier.
+func (q Qualifier) qualifies(low *hand.Hand) bool {
+	q = q.orDefault()
+	if q == NoQualifier {
+		return true
+	}
+	return low.CompareTo(qualifierHands[q]) <= 0
 }
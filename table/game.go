@@ -4,7 +4,6 @@ import (
 	"errors"
 
 	"github.com/rolends1986/poker/hand"
-	"github.com/rolends1986/poker/util"
 )
 
 // A Game represents one of the different poker variations.
@@ -43,11 +42,75 @@ const (
 	// "eight or better" meaning that it must have or be below an eight high.
 	// StudHiLo is typically played Fixed or Pot Limit.
 	StudHiLo
+
+	// ShortDeckHoldem (also known as six plus hold'em) is a version of
+	// Holdem played with a 36 card deck that has the twos through fives
+	// removed.  A flush beats a full house and A-6-7-8-9 is the lowest
+	// straight.  ShortDeckHoldem is typically played No Limit.
+	ShortDeckHoldem
+
+	// OmahaHi5 (also known as five card Omaha) is a version of OmahaHi with
+	// five hole cards.  Hands are still formed from exactly two hole cards
+	// and three board cards.  OmahaHi5 is typically played Pot Limit.
+	OmahaHi5
+
+	// OmahaHiLo5 (also known as Big O) is a version of OmahaHiLo with five
+	// hole cards.  OmahaHiLo5 is typically played Pot Limit.
+	OmahaHiLo5
+
+	// OmahaHi6 (also known as six card Omaha) is a version of OmahaHi with
+	// six hole cards.  OmahaHi6 is typically played Pot Limit.
+	OmahaHi6
+
+	// RoyalHoldem is a version of Holdem played with the 20 tens through
+	// aces.  A flush beats a full house and A-K-Q-J-T is the only
+	// straight.  RoyalHoldem is typically played No Limit.
+	RoyalHoldem
+
+	// FiveCardDraw is a draw game in which players are dealt five concealed
+	// hole cards and may discard and replace any of them once, between two
+	// rounds of betting, to form the best hand.  FiveCardDraw is typically
+	// played Fixed or Pot Limit.
+	FiveCardDraw
+
+	// DeuceToSevenSingleDraw (also known as Kansas City lowball) is a draw
+	// game with one draw in which the lowest deuce to seven hand wins.  Aces
+	// are high and straights and flushes count against the hand.
+	// DeuceToSevenSingleDraw is typically played No Limit.
+	DeuceToSevenSingleDraw
+
+	// DeuceToSevenTripleDraw is a version of DeuceToSevenSingleDraw with
+	// three draws and four rounds of betting.  DeuceToSevenTripleDraw is
+	// typically played Fixed Limit.
+	DeuceToSevenTripleDraw
+
+	// AceToFiveTripleDraw is a draw game with three draws in which the
+	// lowest ace to five hand wins.  Aces are low and straights and flushes
+	// don't count.  AceToFiveTripleDraw is typically played Fixed Limit.
+	AceToFiveTripleDraw
+
+	// Badugi is a draw game with four hole cards and three draws in which
+	// the best Badugi hand wins.  A Badugi is four cards of different ranks
+	// and suits, and more cards of different ranks and suits beat fewer.
+	// Badugi is typically played Fixed Limit.
+	Badugi
+
+	// Pineapple is a version of Holdem in which players are dealt three
+	// hole cards and discard one of them before the betting preflop.
+	// Pineapple is typically played No Limit.
+	Pineapple
+
+	// CrazyPineapple is a version of Pineapple in which players discard
+	// one of their three hole cards after the betting on the flop.
+	// CrazyPineapple is typically played No Limit.
+	CrazyPineapple
 )
 
 // Games returns all Games.
 func Games() []Game {
-	return []Game{Holdem, OmahaHi, OmahaHiLo, Razz, StudHi, StudHiLo}
+	return []Game{Holdem, OmahaHi, OmahaHiLo, Razz, StudHi, StudHiLo, ShortDeckHoldem,
+		OmahaHi5, OmahaHiLo5, OmahaHi6, RoyalHoldem, FiveCardDraw, DeuceToSevenSingleDraw,
+		DeuceToSevenTripleDraw, AceToFiveTripleDraw, Badugi, Pineapple, CrazyPineapple}
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
@@ -81,6 +144,30 @@ func (g Game) get() game {
 		return razz
 	case StudHiLo:
 		return studHiLo
+	case ShortDeckHoldem:
+		return shortDeckHoldem
+	case OmahaHi5:
+		return omahaHi5
+	case OmahaHiLo5:
+		return omahaHiLo5
+	case OmahaHi6:
+		return omahaHi6
+	case RoyalHoldem:
+		return royalHoldem
+	case FiveCardDraw:
+		return fiveCardDraw
+	case DeuceToSevenSingleDraw:
+		return deuceToSevenSingleDraw
+	case DeuceToSevenTripleDraw:
+		return deuceToSevenTripleDraw
+	case AceToFiveTripleDraw:
+		return aceToFiveTripleDraw
+	case Badugi:
+		return badugi
+	case Pineapple:
+		return pineapple
+	case CrazyPineapple:
+		return crazyPineapple
 	}
 	panic("unreachable")
 }
@@ -92,13 +179,62 @@ var (
 	}
 
 	omahaHi game = &holdemGame{
-		Split:   false,
-		IsOmaha: true,
+		Split:          false,
+		IsOmaha:        true,
+		NumOfHoleCards: 4,
 	}
 
 	omahaHiLo game = &holdemGame{
-		Split:   true,
-		IsOmaha: true,
+		Split:          true,
+		IsOmaha:        true,
+		NumOfHoleCards: 4,
+	}
+
+	omahaHi5 game = &holdemGame{
+		Split:          false,
+		IsOmaha:        true,
+		NumOfHoleCards: 5,
+	}
+
+	omahaHiLo5 game = &holdemGame{
+		Split:          true,
+		IsOmaha:        true,
+		NumOfHoleCards: 5,
+	}
+
+	omahaHi6 game = &holdemGame{
+		Split:          false,
+		IsOmaha:        true,
+		NumOfHoleCards: 6,
+	}
+
+	shortDeckHoldem game = &holdemGame{
+		Split:     false,
+		IsOmaha:   false,
+		ShortDeck: true,
+	}
+
+	royalHoldem game = &holdemGame{
+		Split:   false,
+		IsOmaha: false,
+		Royal:   true,
+	}
+
+	pineapple game = &holdemGame{
+		Split:          false,
+		IsOmaha:        false,
+		NumOfHoleCards: 3,
+		Discards:       1,
+		DiscardRound:   preflop,
+	}
+
+	// the discard after the flop betting is before the turn is dealt
+	crazyPineapple game = &holdemGame{
+		Split:          false,
+		IsOmaha:        false,
+		NumOfHoleCards: 3,
+		Discards:       1,
+		DiscardRound:   turn,
 	}
 
 	studHi game = &studGame{
@@ -115,6 +251,39 @@ var (
 		Split:  true,
 		IsRazz: false,
 	}
+
+	fiveCardDraw game = &drawGame{
+		NumOfDraws:     1,
+		NumOfHoleCards: 5,
+	}
+
+	deuceToSevenSingleDraw game = &drawGame{
+		NumOfDraws:     1,
+		NumOfHoleCards: 5,
+		Low:            true,
+		HandOptions:    []func(*hand.Config){hand.DeuceToSevenLow},
+	}
+
+	deuceToSevenTripleDraw game = &drawGame{
+		NumOfDraws:     3,
+		NumOfHoleCards: 5,
+		Low:            true,
+		HandOptions:    []func(*hand.Config){hand.DeuceToSevenLow},
+	}
+
+	aceToFiveTripleDraw game = &drawGame{
+		NumOfDraws:     3,
+		NumOfHoleCards: 5,
+		Low:            true,
+		HandOptions:    []func(*hand.Config){hand.AceToFiveLow},
+	}
+
+	badugi game = &drawGame{
+		NumOfDraws:     3,
+		NumOfHoleCards: 4,
+		Low:            true,
+		HandOptions:    []func(*hand.Config){hand.Badugi},
+	}
 )
 
 type holeCards map[int][]*HoleCard
@@ -128,37 +297,87 @@ type game interface {
 	SplitPot() bool
 	Sorting() hand.Sorting
 	FormHighHand(holeCards []*hand.Card, boardCards []*hand.Card) *hand.Hand
-	FormLowHand(holeCards []*hand.Card, boardCards []*hand.Card) *hand.Hand
+	FormLowHand(holeCards []*hand.Card, boardCards []*hand.Card, q Qualifier) *hand.Hand
 	ForcedBet(holeCards holeCards, opts Config, r round, seat, relativePos int) int
-	RoundStartSeat(holeCards holeCards, r round) int
+	RoundStartSeat(holeCards holeCards, opts Config, r round) int
 	FixedLimit(opts Config, r round) int
+	DeckOptions() []func(*hand.Config)
+
+	// DrawRule returns how players discard before the board cards and
+	// betting of the round.  The zero value means there is no draw.
+	DrawRule(r round) drawRule
+}
+
+// A drawRule is how many hole cards players discard in a draw or discard
+// phase, which isn't a betting round.
+type drawRule struct {
+	// Min and Max are the fewest and most hole cards a player discards.
+	Min, Max int
+
+	// Replace is true if the discards are replaced from the deck, as in
+	// draw games.
+	Replace bool
+}
+
+// action returns the action of a player in the phase.
+func (r drawRule) action() Action {
+	if r.Replace {
+		return Draw
+	}
+	return Discard
 }
 
 type holdemGame struct {
-	Split   bool
-	IsOmaha bool
+	Split     bool
+	IsOmaha   bool
+	ShortDeck bool
+	Royal     bool
+
+	// NumOfHoleCards is the number of hole cards dealt to each player.
+	// Zero deals two.
+	NumOfHoleCards int
+
+	// Discards is the number of hole cards each player discards, without
+	// replacement, before the board cards and betting of DiscardRound.
+	Discards     int
+	DiscardRound round
 }
 
 func (g *holdemGame) NumOfRounds() int {
 	return 4
 }
 
+// MaxSeats returns ten or fewer if the deck can't deal everyone's hole
+// cards and the board.
 func (g *holdemGame) MaxSeats() int {
-	return 10
+	return g.maxSeats(1)
 }
 
-func (g *holdemGame) HoleCards(deck *hand.Deck, r round) []*HoleCard {
-	numOfCards := 2
-	if g.IsOmaha {
-		numOfCards = 4
+// maxSeats returns ten or fewer if the deck can't deal everyone's hole
+// cards and the number of boards.
+func (g *holdemGame) maxSeats(boards int) int {
+	seats := (len(hand.Cards(g.DeckOptions()...)) - 5*boards) / g.numOfHoleCards()
+	if seats > 10 {
+		return 10
 	}
+	return seats
+}
+
+func (g *holdemGame) HoleCards(deck *hand.Deck, r round) []*HoleCard {
 	switch r {
 	case preflop:
-		return holeCardsPopMulti(deck, Concealed, numOfCards)
+		return holeCardsPopMulti(deck, Concealed, g.numOfHoleCards())
 	}
 	return []*HoleCard{}
 }
 
+func (g *holdemGame) numOfHoleCards() int {
+	if g.NumOfHoleCards == 0 {
+		return 2
+	}
+	return g.NumOfHoleCards
+}
+
 func (g *holdemGame) BoardCards(deck *hand.Deck, r round) []*hand.Card {
 	switch r {
 	case flop:
@@ -186,73 +405,38 @@ func (g *holdemGame) Sorting() hand.Sorting {
 func (g *holdemGame) FormHighHand(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
 	if !g.IsOmaha {
 		cards := append(board, holeCards...)
-		return hand.New(cards)
+		return hand.New(cards, g.DeckOptions()...)
 	}
 
-	opts := func(c *hand.Config) {}
-	hands := omahaHands(holeCards, board, opts)
-	hands = hand.Sort(hand.SortingHigh, hand.DESC, hands...)
-	return hands[0]
+	return g.holeCardRule().Hand(holeCards, board)
 }
 
-func (g *holdemGame) FormLowHand(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
+func (g *holdemGame) FormLowHand(holeCards []*hand.Card, board []*hand.Card, q Qualifier) *hand.Hand {
 	if !g.IsOmaha || !g.Split {
 		return nil
 	}
 
-	hands := omahaHands(holeCards, board, hand.AceToFiveLow)
-	hands = hand.Sort(hand.SortingLow, hand.DESC, hands...)
-	if hands[0].CompareTo(eightOrBetter) <= 0 {
-		return hands[0]
+	low := g.holeCardRule().Hand(holeCards, board, hand.AceToFiveLow)
+	if q.qualifies(low) {
+		return low
 	}
 	return nil
 }
 
-func (g *holdemGame) ForcedBet(holeCards holeCards, opts Config, r round, seat, relativePos int) int {
-	chips := 0
-	if r != preflop {
-		return chips
-	}
-
-	chips += opts.Stakes.Ante
-
-	// reduce blind sizes if fixed limit
-	smallBet := opts.Stakes.SmallBet
-	bigBet := opts.Stakes.BigBet
-	if opts.Limit == FixedLimit {
-		smallBet /= 2
-		bigBet /= 2
+// holeCardRule returns how hands are formed from the hole cards.
+func (g *holdemGame) holeCardRule() hand.HoleCardRule {
+	if g.IsOmaha {
+		return hand.Omaha(g.numOfHoleCards())
 	}
+	return hand.AnyTwo
+}
 
-	numOfPlayers := len(holeCards)
-	if numOfPlayers == 2 {
-		switch relativePos {
-		case 0:
-			chips += smallBet
-		case 1:
-			chips += bigBet
-		}
-	} else {
-		switch relativePos {
-		case 1:
-			chips += smallBet
-		case 2:
-			chips += bigBet
-		}
-	}
-	return chips
+func (g *holdemGame) ForcedBet(holeCards holeCards, opts Config, r round, seat, relativePos int) int {
+	return blindBet(holeCards, opts, r, relativePos)
 }
 
-func (g *holdemGame) RoundStartSeat(holeCards holeCards, r round) int {
-	numOfPlayers := len(holeCards)
-	if r != preflop {
-		return 1
-	}
-	switch numOfPlayers {
-	case 2, 3:
-		return 0
-	}
-	return 3
+func (g *holdemGame) RoundStartSeat(holeCards holeCards, opts Config, r round) int {
+	return blindStartSeat(holeCards, opts, r)
 }
 
 func (g *holdemGame) FixedLimit(opts Config, r round) int {
@@ -263,6 +447,23 @@ func (g *holdemGame) FixedLimit(opts Config, r round) int {
 	return opts.Stakes.SmallBet
 }
 
+func (g *holdemGame) DeckOptions() []func(*hand.Config) {
+	switch {
+	case g.ShortDeck:
+		return []func(*hand.Config){hand.ShortDeck}
+	case g.Royal:
+		return []func(*hand.Config){hand.Royal}
+	}
+	return nil
+}
+
+func (g *holdemGame) DrawRule(r round) drawRule {
+	if g.Discards == 0 || r != g.DiscardRound {
+		return drawRule{}
+	}
+	return drawRule{Min: g.Discards, Max: g.Discards}
+}
+
 type studGame struct {
 	Split  bool
 	IsRazz bool
@@ -323,10 +524,13 @@ func (g *studGame) FormHighHand(holeCards []*hand.Card, board []*hand.Card) *han
 	return hand.New(cards)
 }
 
-func (g *studGame) FormLowHand(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
+func (g *studGame) FormLowHand(holeCards []*hand.Card, board []*hand.Card, q Qualifier) *hand.Hand {
+	if !g.Split {
+		return nil
+	}
 	cards := append(board, holeCards...)
 	hand := hand.New(cards, hand.AceToFiveLow)
-	if hand.CompareTo(eightOrBetter) <= 0 {
+	if q.qualifies(hand) {
 		return hand
 	}
 	return nil
@@ -339,7 +543,7 @@ func (g *studGame) ForcedBet(holeCards holeCards, opts Config, r round, seat, re
 	}
 
 	chips += opts.Stakes.Ante
-	startSeat := g.RoundStartSeat(holeCards, r)
+	startSeat := g.RoundStartSeat(holeCards, opts, r)
 	if startSeat == seat {
 		chips += opts.Stakes.SmallBet
 	}
@@ -347,7 +551,7 @@ func (g *studGame) ForcedBet(holeCards holeCards, opts Config, r round, seat, re
 	return chips
 }
 
-func (g *studGame) RoundStartSeat(holeCards holeCards, r round) int {
+func (g *studGame) RoundStartSeat(holeCards holeCards, opts Config, r round) int {
 	exposed := exposedCards(holeCards)
 	f := func(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
 		return hand.New(holeCards)
@@ -374,33 +578,165 @@ func (g *studGame) FixedLimit(opts Config, r round) int {
 	return opts.Stakes.BigBet
 }
 
-func omahaHands(holeCards []*hand.Card, board []*hand.Card, opts func(*hand.Config)) []*hand.Hand {
-	hands := []*hand.Hand{}
-	selected := make([]*hand.Card, 2)
-	boardSelected := make([]*hand.Card, 3)
-	totalBoardCard := len(board)
+func (g *studGame) DeckOptions() []func(*hand.Config) {
+	return nil
+}
 
-	for _, indexes := range util.Combinations(4, 2) {
-		for j, i := range indexes {
-			selected[j] = holeCards[i]
+func (g *studGame) DrawRule(r round) drawRule {
+	return drawRule{}
+}
+
+// blindBet returns the forced bet of the relative position in games with
+// a button and blinds.
+func blindBet(holeCards holeCards, opts Config, r round, relativePos int) int {
+	chips := 0
+	if r != preflop {
+		return chips
+	}
+
+	chips += opts.Stakes.Ante
+	if opts.AnteOnly {
+		return chips
+	}
+
+	// reduce blind sizes if fixed limit
+	smallBet := opts.Stakes.SmallBet
+	bigBet := opts.Stakes.BigBet
+	if opts.Limit == FixedLimit {
+		smallBet /= 2
+		bigBet /= 2
+	}
+
+	numOfPlayers := len(holeCards)
+	if numOfPlayers == 2 {
+		switch relativePos {
+		case 0:
+			chips += smallBet
+		case 1:
+			chips += bigBet
 		}
-		if totalBoardCard > 0 {
-			for _, boardIndexs := range util.Combinations(totalBoardCard, 3) {
-				for m, n := range boardIndexs {
-					if totalBoardCard > 0 {
-						boardSelected[m] = board[n]
-					}
-				}
-				cards := append(boardSelected, selected...)
-				hands = append(hands, hand.New(cards, opts))
-			}
-		} else {
-			cards := []*hand.Card{}
-			cards = append(cards, selected...)
-			hands = append(hands, hand.New(cards, opts))
+	} else {
+		switch relativePos {
+		case 1:
+			chips += smallBet
+		case 2:
+			chips += bigBet
+		}
+	}
+	return chips
+}
+
+// blindStartSeat returns the relative position of the first player to
+// act in games with a button and blinds.
+func blindStartSeat(holeCards holeCards, opts Config, r round) int {
+	numOfPlayers := len(holeCards)
+	if r != preflop {
+		return 1
+	}
+	if opts.AnteOnly {
+		// the player after the button acts first, which heads up is
+		// the button
+		if numOfPlayers == 2 {
+			return 0
 		}
+		return 1
+	}
+	switch numOfPlayers {
+	case 2, 3:
+		return 0
+	}
+	return 3
+}
+
+type drawGame struct {
+	// NumOfDraws is the number of draws, each followed by a round of
+	// betting.
+	NumOfDraws int
+
+	// NumOfHoleCards is the number of hole cards dealt to each player.
+	NumOfHoleCards int
+
+	// Low is true if the lowest hand wins.
+	Low bool
+
+	// HandOptions configure how hands are formed and ranked.
+	HandOptions []func(*hand.Config)
+}
+
+func (g *drawGame) NumOfRounds() int {
+	return g.NumOfDraws + 1
+}
+
+// MaxSeats returns the seats the deck can deal with the discards shuffled
+// into a new stub.
+func (g *drawGame) MaxSeats() int {
+	if g.NumOfHoleCards == 4 {
+		return 8
 	}
-	return hands
+	return 6
+}
+
+func (g *drawGame) HoleCards(deck *hand.Deck, r round) []*HoleCard {
+	switch r {
+	case predraw:
+		return holeCardsPopMulti(deck, Concealed, g.NumOfHoleCards)
+	}
+	return []*HoleCard{}
+}
+
+func (g *drawGame) BoardCards(deck *hand.Deck, r round) []*hand.Card {
+	return []*hand.Card{}
+}
+
+func (g *drawGame) ShowBoardCards(deck *hand.Deck, start, end int) []*hand.Card {
+	return []*hand.Card{}
+}
+
+func (g *drawGame) SplitPot() bool {
+	return false
+}
+
+func (g *drawGame) Sorting() hand.Sorting {
+	if g.Low {
+		return hand.SortingLow
+	}
+	return hand.SortingHigh
+}
+
+func (g *drawGame) FormHighHand(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
+	return hand.New(holeCards, g.HandOptions...)
+}
+
+func (g *drawGame) FormLowHand(holeCards []*hand.Card, board []*hand.Card, q Qualifier) *hand.Hand {
+	return nil
+}
+
+func (g *drawGame) ForcedBet(holeCards holeCards, opts Config, r round, seat, relativePos int) int {
+	return blindBet(holeCards, opts, r, relativePos)
+}
+
+func (g *drawGame) RoundStartSeat(holeCards holeCards, opts Config, r round) int {
+	return blindStartSeat(holeCards, opts, r)
+}
+
+// FixedLimit returns the small bet for the first half of the betting
+// rounds and the big bet for the rest.
+func (g *drawGame) FixedLimit(opts Config, r round) int {
+	if int(r) < g.NumOfRounds()/2 {
+		return opts.Stakes.SmallBet
+	}
+	return opts.Stakes.BigBet
+}
+
+func (g *drawGame) DeckOptions() []func(*hand.Config) {
+	return nil
+}
+
+func (g *drawGame) DrawRule(r round) drawRule {
+	if r == predraw {
+		return drawRule{}
+	}
+	return drawRule{Max: g.NumOfHoleCards, Replace: true}
 }
 
 func exposedCards(holeCards map[int][]*HoleCard) map[int][]*hand.Card {
@@ -430,14 +766,8 @@ const (
 	fifthSt   round = 2
 	sixthSt   round = 3
 	seventhSt round = 4
-)
 
-var (
-	eightOrBetter = hand.New([]*hand.Card{
-		hand.EightSpades,
-		hand.SevenSpades,
-		hand.SixSpades,
-		hand.FiveSpades,
-		hand.FourSpades,
-	}, hand.AceToFiveLow)
+	// predraw is the round of betting before the first draw.  Every
+	// following round starts with a draw.
+	predraw round = 0
 )
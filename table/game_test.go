@@ -2,6 +2,7 @@ package table
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/rolends1986/poker/hand"
@@ -39,6 +40,68 @@ var tests = []struct {
 		HighRanking:    hand.Straight,
 		LowRanking:     hand.HighCard,
 	},
+	{
+		G:              ShortDeckHoldem,
+		Cards:          pokertest.Cards("Ah", "Ks", "6s", "7d", "8s", "9h", "Tc", "Ah", "Kh"),
+		NumOfHoleCards: 2,
+		NumOfBoard:     5,
+		HighRanking:    hand.Straight,
+	},
+	{
+		G:              OmahaHi5,
+		Cards:          pokertest.Cards("As", "Ks", "Qs", "Js", "Ts", "2s", "3s", "4s", "8c", "9d"),
+		NumOfHoleCards: 5,
+		NumOfBoard:     5,
+		HighRanking:    hand.Flush,
+	},
+	{
+		G:              OmahaHiLo5,
+		Cards:          pokertest.Cards("Ah", "2d", "Kc", "Kd", "Qs", "3s", "4h", "8c", "9d", "Js"),
+		NumOfHoleCards: 5,
+		NumOfBoard:     5,
+		HighRanking:    hand.Pair,
+		LowRanking:     hand.HighCard,
+	},
+	{
+		G:              OmahaHi6,
+		Cards:          pokertest.Cards("As", "Ad", "Ah", "Ac", "Kd", "Kh", "2c", "7d", "9s", "Th", "3c"),
+		NumOfHoleCards: 6,
+		NumOfBoard:     5,
+		HighRanking:    hand.Pair,
+	},
+	{
+		G:              FiveCardDraw,
+		Cards:          pokertest.Cards("Ah", "Ad", "Kc", "Kd", "Qs"),
+		NumOfHoleCards: 5,
+		NumOfBoard:     0,
+		HighRanking:    hand.TwoPair,
+	},
+	{
+		G:              DeuceToSevenTripleDraw,
+		Cards:          pokertest.Cards("7s", "5d", "4h", "3c", "2s"),
+		NumOfHoleCards: 5,
+		NumOfBoard:     0,
+		HighRanking:    hand.HighCard,
+	},
+	{
+		G:              Badugi,
+		Cards:          pokertest.Cards("As", "2h", "3d", "4c"),
+		NumOfHoleCards: 4,
+		NumOfBoard:     0,
+		HighRanking:    hand.HighCard,
+	},
+}
+
+func TestMaxSeats(t *testing.T) {
+	t.Parallel()
+	seats := map[Game]int{Holdem: 10, OmahaHi: 10, OmahaHi5: 9, OmahaHiLo5: 9, OmahaHi6: 7,
+		ShortDeckHoldem: 10, RoyalHoldem: 7, FiveCardDraw: 6, DeuceToSevenTripleDraw: 6, Badugi: 8,
+		Pineapple: 10}
+	for g, want := range seats {
+		if got := g.get().MaxSeats(); got != want {
+			t.Errorf("%v MaxSeats() = %d; want %d", g, got, want)
+		}
+	}
 }
 
 func TestDealingAndEvalutions(t *testing.T) {
@@ -63,7 +126,7 @@ func TestDealingAndEvalutions(t *testing.T) {
 			t.Errorf("%v's high hand formation = %v; want %v", s.G, highHand.Ranking(), s.HighRanking)
 		}
 
-		lowHand := g.FormLowHand(hCards, board)
+		lowHand := g.FormLowHand(hCards, board, EightOrBetter)
 		if !equalRanking(lowHand, s.LowRanking) {
 			t.Errorf("%v's low hand formation = %v; want %v", s.G, lowHand.Ranking(), s.LowRanking)
 		}
@@ -208,8 +271,8 @@ func BenchmarkHoldemShowdown(b *testing.B) {
 		}
 		hCards := cardsFromHoleCardMap(holeCards)
 		highHands := newHands(hCards, board, Holdem.get().FormHighHand)
-		lowHands := newHands(hCards, board, Holdem.get().FormLowHand)
-		p.payout(0, tbl, highHands, lowHands, hand.SortingHigh, 0)
+		lowHands := newHands(hCards, board, tbl.formLowHand)
+		p.payout(0, tbl, []Hands{highHands}, []Hands{lowHands}, hand.SortingHigh, 0)
 	}
 }
 
@@ -251,8 +314,8 @@ func BenchmarkOmahaHiShowdown(b *testing.B) {
 		}
 		hCards := cardsFromHoleCardMap(holeCards)
 		highHands := newHands(hCards, board, tbl.game().FormHighHand)
-		lowHands := newHands(hCards, board, tbl.game().FormLowHand)
-		payout := p.payout(0, tbl, highHands, lowHands, hand.SortingHigh, 0)
+		lowHands := newHands(hCards, board, tbl.formLowHand)
+		payout := p.payout(0, tbl, []Hands{highHands}, []Hands{lowHands}, hand.SortingHigh, 0)
 		for _, results := range payout {
 			fmt.Println(results)
 		}
@@ -276,3 +339,342 @@ func dealOut(g game, cards []*hand.Card) (holeCards []*hand.Card, board []*hand.
 	}
 	return
 }
+
+func TestShortDeckHoldemDeck(t *testing.T) {
+	t.Parallel()
+	opts := Config{
+		Game:       ShortDeckHoldem,
+		Limit:      NoLimit,
+		Stakes:     Stakes{SmallBet: 1, BigBet: 2},
+		NumOfSeats: 6,
+	}
+	tbl := New(opts, hand.NewDealer())
+	tbl.deck = tbl.newDeck()
+	if l := len(tbl.deck.Cards); l != 36 {
+		t.Fatalf("short deck holdem deck len = %d; want %d", l, 36)
+	}
+}
+
+func TestJokerDeckPanics(t *testing.T) {
+	t.Parallel()
+	tbl := New(Config{Game: FiveCardDraw, NumOfSeats: 6}, hand.NewDealer(hand.Jokers(1)))
+	defer func() {
+		if recover() == nil {
+			t.Error("newDeck() should panic when the dealer deals a joker")
+		}
+	}()
+	tbl.newDeck()
+}
+
+func TestRoyalHoldemDeck(t *testing.T) {
+	t.Parallel()
+	opts := Config{
+		Game:       RoyalHoldem,
+		Limit:      NoLimit,
+		Stakes:     Stakes{SmallBet: 1, BigBet: 2},
+		NumOfSeats: 7,
+	}
+	tbl := New(opts, hand.NewDealer())
+	tbl.deck = tbl.newDeck()
+	if l := len(tbl.deck.Cards); l != 20 {
+		t.Fatalf("royal holdem deck len = %d; want %d", l, 20)
+	}
+
+	g := RoyalHoldem.get()
+	straight := g.FormHighHand(pokertest.Cards("As", "Ts"), pokertest.Cards("Js", "Ks", "Kh", "Kd", "Qh"))
+	if straight.Description() != "straight ace high" {
+		t.Fatalf("royal holdem hand = %v; want straight ace high", straight)
+	}
+}
+
+func TestAnteOnly(t *testing.T) {
+	t.Parallel()
+	h := ShortDeckHoldem.get()
+	opts := Config{
+		Game:       ShortDeckHoldem,
+		Stakes:     Stakes{SmallBet: 5, BigBet: 10, Ante: 2},
+		NumOfSeats: 6,
+		Limit:      NoLimit,
+		AnteOnly:   true,
+	}
+	holeCards := map[int][]*HoleCard{0: {}, 1: {}, 2: {}, 3: {}}
+	for pos := 0; pos < 4; pos++ {
+		if chips := h.ForcedBet(holeCards, opts, preflop, pos, pos); chips != 2 {
+			t.Fatalf("ante only forced bet at %d = %d; want %d", pos, chips, 2)
+		}
+	}
+	if pos := h.RoundStartSeat(holeCards, opts, preflop); pos != 1 {
+		t.Fatalf("ante only preflop start = %d; want %d", pos, 1)
+	}
+	headsUp := map[int][]*HoleCard{0: {}, 1: {}}
+	if pos := h.RoundStartSeat(headsUp, opts, preflop); pos != 0 {
+		t.Fatalf("heads up ante only preflop start = %d; want %d", pos, 0)
+	}
+
+	opts.AnteOnly = false
+	if chips := h.ForcedBet(holeCards, opts, preflop, 2, 2); chips != 12 {
+		t.Fatalf("big blind and ante = %d; want %d", chips, 12)
+	}
+}
+
+func TestAnteOnlyFirstToAct(t *testing.T) {
+	t.Parallel()
+	opts := Config{
+		Game:       ShortDeckHoldem,
+		Stakes:     Stakes{SmallBet: 5, BigBet: 10, Ante: 2},
+		NumOfSeats: 6,
+		Limit:      NoLimit,
+		AnteOnly:   true,
+	}
+	tbl := New(opts, hand.NewDealer())
+	for seat := 0; seat < 4; seat++ {
+		if err := tbl.Sit(&player{id: int64(seat)}, seat, 100, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := tbl.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if want := (tbl.Button() + 1) % 4; tbl.Action() != want {
+		t.Fatalf("ante only first to act = seat %d with the button at %d; want seat %d", tbl.Action(), tbl.Button(), want)
+	}
+	for seat, p := range tbl.Players() {
+		if p.Chips() != 98 {
+			t.Fatalf("seat %d chips = %d; want 98 after the ante", seat, p.Chips())
+		}
+	}
+}
+
+func TestQualifier(t *testing.T) {
+	t.Parallel()
+	g := StudHiLo.get()
+	holeCards := pokertest.Cards("9s", "7h", "5d", "3c", "2s", "Kd", "Kh")
+	qualifierTests := []struct {
+		q   Qualifier
+		low bool
+	}{
+		{"", false},
+		{NoQualifier, true},
+		{SevenOrBetter, false},
+		{EightOrBetter, false},
+		{NineOrBetter, true},
+	}
+	for _, test := range qualifierTests {
+		low := g.FormLowHand(holeCards, nil, test.q)
+		if (low != nil) != test.low {
+			t.Errorf("%q low = %v; want a low %v", test.q, low, test.low)
+		}
+	}
+
+	if low := StudHi.get().FormLowHand(holeCards, nil, NoQualifier); low != nil {
+		t.Errorf("StudHi low = %v; want none", low)
+	}
+}
+
+func TestEquityOptions(t *testing.T) {
+	t.Parallel()
+	holeCards := [][]*hand.Card{
+		pokertest.Cards("9s", "2d", "Tc", "Th"),
+		pokertest.Cards("Qs", "Qd", "Jc", "Jh"),
+	}
+	board := pokertest.Cards("3c", "4h", "5d", "Qh", "Kd")
+	equityTests := []struct {
+		q      Qualifier
+		equity float64
+	}{
+		{"", 0},
+		{EightOrBetter, 0},
+		{NineOrBetter, 50},
+		{NoQualifier, 50},
+	}
+	for _, test := range equityTests {
+		tbl := New(Config{Game: OmahaHiLo, NumOfSeats: 6, Qualifier: test.q}, hand.NewDealer())
+		r, err := hand.CalcEquity(holeCards, board, nil, tbl.EquityOptions()...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e := r.Players[0].Equity; math.Abs(e-test.equity) > 0.001 {
+			t.Errorf("%q nine low equity = %.2f%%; want %.2f%%", test.q, e, test.equity)
+		}
+	}
+
+	if opts := New(Config{Game: StudHiLo, NumOfSeats: 6}, hand.NewDealer()).EquityOptions(); opts != nil {
+		t.Errorf("StudHiLo EquityOptions() = %v; want nil", opts)
+	}
+}
+
+func TestDrawRounds(t *testing.T) {
+	t.Parallel()
+	opts := Config{Stakes: Stakes{SmallBet: 2, BigBet: 4}}
+	drawTests := []struct {
+		G      Game
+		Limits []int
+	}{
+		{FiveCardDraw, []int{2, 4}},
+		{DeuceToSevenSingleDraw, []int{2, 4}},
+		{DeuceToSevenTripleDraw, []int{2, 2, 4, 4}},
+		{AceToFiveTripleDraw, []int{2, 2, 4, 4}},
+		{Badugi, []int{2, 2, 4, 4}},
+	}
+	for _, test := range drawTests {
+		g := test.G.get()
+		if n := g.NumOfRounds(); n != len(test.Limits) {
+			t.Errorf("%v NumOfRounds() = %d; want %d", test.G, n, len(test.Limits))
+		}
+		for r, want := range test.Limits {
+			if got := g.FixedLimit(opts, round(r)); got != want {
+				t.Errorf("%v FixedLimit(%d) = %d; want %d", test.G, r, got, want)
+			}
+			if draw := g.DrawRule(round(r)).Replace; draw != (r > 0) {
+				t.Errorf("%v round %d has a draw %v; want %v", test.G, r, draw, r > 0)
+			}
+		}
+	}
+	if Holdem.get().DrawRule(flop) != (drawRule{}) {
+		t.Error("Holdem shouldn't have a draw")
+	}
+}
+
+func TestDrawCardsReshufflesMuck(t *testing.T) {
+	t.Parallel()
+	tbl := New(Config{Game: DeuceToSevenTripleDraw, NumOfSeats: 6}, hand.NewDealer())
+	tbl.deck = &hand.Deck{Cards: pokertest.Cards("2s", "3s")}
+	tbl.muck = pokertest.Cards("4s", "5s", "6s")
+
+	cards := tbl.drawCards(4)
+	if len(cards) != 4 {
+		t.Fatalf("drawCards(4) = %v; want 4 cards", cards)
+	}
+	dealt := hand.NewCardSet(cards...).Union(hand.NewCardSet(tbl.deck.Cards...))
+	if dealt != hand.NewCardSet(pokertest.Cards("2s", "3s", "4s", "5s", "6s")...) {
+		t.Fatalf("drawCards(4) = %v with %v left; want the stub and muck", cards, tbl.deck)
+	}
+	if len(tbl.muck) != 0 {
+		t.Fatalf("muck = %v; want it shuffled into the stub", tbl.muck)
+	}
+}
+
+func TestDrawCardsDealerShuffle(t *testing.T) {
+	t.Parallel()
+	draw := func() []*hand.Card {
+		tbl := New(Config{Game: DeuceToSevenTripleDraw, NumOfSeats: 6}, hand.NewSeededDealer(42))
+		tbl.deck = &hand.Deck{Cards: []*hand.Card{}}
+		tbl.muck = pokertest.Cards("2s", "3s", "4s", "5s", "6s", "7s", "8s", "9s")
+		return tbl.drawCards(8)
+	}
+	if a, b := draw(), draw(); fmt.Sprint(a) != fmt.Sprint(b) {
+		t.Fatalf("seeded reshuffles = %v and %v; want the same order", a, b)
+	}
+}
+
+// deckDealer is a dealer that isn't a hand.Shuffler.
+type deckDealer struct {
+	hand.Dealer
+}
+
+func TestDrawCardsWithoutShuffler(t *testing.T) {
+	t.Parallel()
+	tbl := New(Config{Game: DeuceToSevenTripleDraw, NumOfSeats: 6}, deckDealer{hand.NewDealer()})
+	tbl.deck = &hand.Deck{Cards: []*hand.Card{}}
+	tbl.muck = pokertest.Cards("2s", "3s", "4s")
+	cards := tbl.drawCards(3)
+	if hand.NewCardSet(cards...) != hand.NewCardSet(pokertest.Cards("2s", "3s", "4s")...) {
+		t.Fatalf("drawCards(3) = %v; want the muck", cards)
+	}
+}
+
+// drawer is a player who always discards the same cards.
+type drawer struct {
+	player
+	discards []*hand.Card
+}
+
+func (p *drawer) Discards() []*hand.Card {
+	return p.discards
+}
+
+func TestDrawInsufficientCards(t *testing.T) {
+	t.Parallel()
+	tbl := New(Config{Game: FiveCardDraw, NumOfSeats: 6}, hand.NewDealer())
+	tbl.round = 1
+	tbl.deck = &hand.Deck{Cards: pokertest.Cards("2s")}
+	tbl.muck = []*hand.Card{}
+	holeCards := pokertest.Cards("As", "Kd", "Qh", "Jc", "9s")
+	p := &PlayerState{player: &drawer{discards: holeCards[:2]}}
+	for _, card := range holeCards {
+		p.holeCards = append(p.holeCards, newHoleCard(card, Concealed))
+	}
+	tbl.players[0] = p
+
+	if err := tbl.handleDraw(0, p, Draw, false); err != ErrInsufficientCards {
+		t.Fatalf("handleDraw() error = %v; want %v", err, ErrInsufficientCards)
+	}
+	if len(p.holeCards) != 5 || len(tbl.deck.Cards) != 1 {
+		t.Fatalf("hole cards = %v with %v left; want the draw undone", p.holeCards, tbl.deck)
+	}
+
+	p.player = &drawer{discards: holeCards[:1]}
+	if err := tbl.handleDraw(0, p, Draw, false); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.holeCards) != 5 {
+		t.Fatalf("hole cards = %v; want 5 cards", p.holeCards)
+	}
+}
+
+func TestPineappleDiscards(t *testing.T) {
+	t.Parallel()
+	discardTests := []struct {
+		G     Game
+		Round round
+	}{
+		{Pineapple, preflop},
+		{CrazyPineapple, turn},
+	}
+	for _, test := range discardTests {
+		g := test.G.get()
+		for r := preflop; r <= river; r++ {
+			want := drawRule{}
+			if r == test.Round {
+				want = drawRule{Min: 1, Max: 1}
+			}
+			if got := g.DrawRule(r); got != want {
+				t.Errorf("%v DrawRule(%d) = %+v; want %+v", test.G, r, got, want)
+			}
+		}
+	}
+}
+
+func TestDefaultDiscards(t *testing.T) {
+	t.Parallel()
+	defaultTests := []struct {
+		G         Game
+		HoleCards []*hand.Card
+		Board     []*hand.Card
+		Discard   *hand.Card
+	}{
+		{Pineapple, pokertest.Cards("As", "7c", "Ad"), nil, hand.SevenClubs},
+		{CrazyPineapple, pokertest.Cards("As", "Kd", "7c"), pokertest.Cards("7h", "7s", "2d"), hand.KingDiamonds},
+	}
+	for _, test := range defaultTests {
+		tbl := New(Config{Game: test.G, NumOfSeats: 6}, hand.NewDealer())
+		tbl.boards = [][]*hand.Card{test.Board}
+		p := &PlayerState{holeCards: []*HoleCard{}}
+		for _, card := range test.HoleCards {
+			p.holeCards = append(p.holeCards, newHoleCard(card, Concealed))
+		}
+		rule := drawRule{Min: 1, Max: 1}
+		discards := tbl.defaultDiscards(p, rule)
+		if len(discards) != 1 || discards[0] != test.Discard {
+			t.Errorf("%v default discards of %v = %v; want %v", test.G, test.HoleCards, discards, test.Discard)
+		}
+		if !tbl.validDiscards(p, discards, rule) {
+			t.Errorf("%v default discards %v aren't valid", test.G, discards)
+		}
+	}
+
+	tbl := New(Config{Game: DeuceToSevenSingleDraw, NumOfSeats: 6}, hand.NewDealer())
+	if discards := tbl.defaultDiscards(&PlayerState{}, drawRule{Max: 5, Replace: true}); len(discards) != 0 {
+		t.Errorf("draw default discards = %v; want to stand pat", discards)
+	}
+}
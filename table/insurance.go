@@ -0,0 +1,326 @@
+package table
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rolends1986/poker/hand"
+)
+
+const (
+	// InsurancePayout indicates that an out hit and the insurance paid.
+	InsurancePayout Share = "InsurancePayout"
+
+	// InsurancePremium indicates that no out hit and the premium was lost.
+	InsurancePremium Share = "InsurancePremium"
+)
+
+// DefaultInsuranceOdds are the odds paid on insurance keyed by the number
+// of outs.  Pots with more outs than the table has odds for can't be
+// insured.
+var DefaultInsuranceOdds = map[int]float64{
+	1:  30,
+	2:  16,
+	3:  10,
+	4:  8,
+	5:  6,
+	6:  5,
+	7:  4,
+	8:  3.5,
+	9:  3,
+	10: 2.5,
+	11: 2.2,
+	12: 2,
+	13: 1.8,
+	14: 1.6,
+	15: 1.4,
+	16: 1.2,
+	17: 1,
+	18: 0.8,
+	19: 0.6,
+	20: 0.5,
+}
+
+// Insurance is an offer of insurance to the player leading a pot with a
+// player all in.  Once the player answers with the Insure action it is an
+// entry of the hand's insurance ledger.
+type Insurance struct {
+	// Seat is the seat of the leading player.
+	Seat int `json:"seat" bson:"seat"`
+
+	// PotNo is the side pot insured.
+	PotNo int `json:"potNo" bson:"potNo"`
+
+	// Round is the round whose board card is insured.
+	Round int `json:"round" bson:"round"`
+
+	// Outs are the cards that make another player in the pot the
+	// leader.
+	Outs []*hand.Card `json:"outs" bson:"outs"`
+
+	// Odds are the chips paid for each chip of premium if an out hits.
+	Odds float64 `json:"odds" bson:"odds"`
+
+	// Max is the most premium the player may buy, which can't pay more
+	// than the other players' chips in the pot or be more than the chips
+	// the player has behind.
+	Max int `json:"max" bson:"max"`
+
+	// Amount is the premium the player bought, or zero if declined.  It
+	// is taken from the player's chips when bought.
+	Amount int `json:"amount" bson:"amount"`
+
+	// Settled is true once the round's card is dealt.
+	Settled bool `json:"settled" bson:"settled"`
+
+	// Hit is true if the card dealt was an out.
+	Hit bool `json:"hit" bson:"hit"`
+
+	// Payout is the chips won if an out hit.
+	Payout int `json:"payout" bson:"payout"`
+
+	// BoughtBack is true if an out hit but the player won the pot all the
+	// same, so the payout was bought back and the premium lost as if no
+	// out hit.
+	BoughtBack bool `json:"boughtBack,omitempty" bson:"boughtBack,omitempty"`
+}
+
+// String returns a string useful for debugging.
+func (i *Insurance) String() string {
+	const format = "seat %d insured %d chips of pot %d at %v to 1 against %d outs in round %d"
+	return fmt.Sprintf(format, i.Seat, i.Amount, i.PotNo, i.Odds, len(i.Outs), i.Round)
+}
+
+// Insuring returns whether the current player is being offered
+// insurance.
+func (t *Table) Insuring() bool {
+	return t.insuring
+}
+
+// InsuranceOffer returns the insurance offered to the current player, or
+// nil if the table isn't insuring.
+func (t *Table) InsuranceOffer() *Insurance {
+	if !t.insuring {
+		return nil
+	}
+	offer := *t.insurance[len(t.insurance)-1]
+	return &offer
+}
+
+// InsuranceLedger returns the insurance offered in the current or last
+// hand, including offers declined.
+func (t *Table) InsuranceLedger() []Insurance {
+	n := len(t.insurance)
+	if t.insuring {
+		n--
+	}
+	ledger := []Insurance{}
+	for _, i := range t.insurance[:n] {
+		ledger = append(ledger, *i)
+	}
+	return ledger
+}
+
+// InsuranceResults returns the results of the last hand's insurance,
+// which are separate from the results of the pots.  Payouts have positive
+// chips and premiums negative chips.
+func (t *Table) InsuranceResults() Results {
+	return t.settlements
+}
+
+// insuranceOdds returns the table's odds or the default odds.
+func (t *Table) insuranceOdds() map[int]float64 {
+	if t.opts.InsuranceOdds == nil {
+		return DefaultInsuranceOdds
+	}
+	return t.opts.InsuranceOdds
+}
+
+// startInsurance offers insurance on the next board card to the player
+// leading the largest pot with a player all in.  It returns false if
+// there is nothing to insure.
+func (t *Table) startInsurance() bool {
+	offer := t.insuranceOffer()
+	if offer == nil {
+		return false
+	}
+	t.insurance = append(t.insurance, offer)
+	t.insuring = true
+	t.action = offer.Seat
+	return true
+}
+
+// insuranceOffer returns the insurance on the next board card, or nil
+// once everyone left is all in unless a single player leads the largest
+// pot with a player all in, the other players have outs the table has
+// odds for and the leader has chips behind to pay the premium.
+func (t *Table) insuranceOffer() *Insurance {
+	next := t.round + 1
+	if !t.opts.Insurance || !t.showdown || len(t.boards) > 1 || next >= t.game().NumOfRounds() {
+		return nil
+	}
+	board := t.Board()
+	if len(board) < 3 {
+		return nil
+	}
+	for _, i := range t.insurance {
+		if i.Round == next {
+			return nil
+		}
+	}
+
+	potNo, pot := t.insurablePot()
+	if pot == nil {
+		return nil
+	}
+	holeCards := map[int][]*hand.Card{}
+	for seat, chips := range pot.contributions {
+		if p := t.players[seat]; p != nil && !p.out && chips > 0 {
+			holeCards[seat] = cardsFromHoleCards(p.holeCards)
+		}
+	}
+	leaders := newHands(holeCards, board, t.game().FormHighHand).winningHands(hand.SortingHigh)
+	if len(leaders) != 1 {
+		return nil
+	}
+	seat := -1
+	for s := range leaders {
+		seat = s
+	}
+	others := [][]*hand.Card{}
+	for s, cards := range holeCards {
+		if s != seat {
+			others = append(others, cards)
+		}
+	}
+	rule := t.game().(*holdemGame).holeCardRule()
+	outs := CalcOutsWithRule(rule, holeCards[seat], others, board, false)
+	odds, ok := t.insuranceOdds()[len(outs)]
+	if len(outs) == 0 || !ok || odds <= 0 {
+		return nil
+	}
+
+	// the payout can't be more than the other players' chips in the pot
+	// and the premium is paid from the chips the leader has behind
+	atStake := pot.Chips() - pot.contributions[seat]
+	max := int(float64(atStake) / odds)
+	if max > atStake {
+		max = atStake
+	}
+	if behind := t.players[seat].chips; max > behind {
+		max = behind
+	}
+	if max < 1 {
+		return nil
+	}
+	return &Insurance{
+		Seat:  seat,
+		PotNo: potNo,
+		Round: next,
+		Outs:  outs,
+		Odds:  odds,
+		Max:   max,
+	}
+}
+
+// insurablePot returns the largest pot with a player all in and more
+// than one player left, or nil if there isn't one.
+func (t *Table) insurablePot() (int, *Pot) {
+	pots := t.sidePots
+	if len(pots) == 0 {
+		pots = []*Pot{t.pot}
+	}
+	potNo, insurable := -1, (*Pot)(nil)
+	for i, pot := range pots {
+		left, allin := 0, false
+		for seat, chips := range pot.contributions {
+			if p := t.players[seat]; p != nil && !p.out && chips > 0 {
+				left++
+				allin = allin || p.allin
+			}
+		}
+		if left > 1 && allin && (insurable == nil || pot.Chips() > insurable.Chips()) {
+			potNo, insurable = i, pot
+		}
+	}
+	return potNo, insurable
+}
+
+// handleInsurance records the premium the player buys and takes it from
+// the player's chips.  A player who times out declines the insurance.
+func (t *Table) handleInsurance(p *PlayerState, a Action, chips int, timeout bool) error {
+	offer := t.insurance[len(t.insurance)-1]
+	if timeout {
+		chips = 0
+	} else if a != Insure {
+		return ErrInvalidAction
+	} else if chips < 0 || chips > offer.Max {
+		return ErrInvalidInsurance
+	}
+	offer.Amount = chips
+	p.chips -= chips
+
+	player := p.Player()
+	playerAction := PlayerAction{
+		PlayerId:   player.ID(),
+		Action:     Insure,
+		Chips:      chips,
+		ActionTime: time.Now().UTC(),
+		Timeout:    timeout,
+		RoundPot:   p.roundPot,
+		Pot:        p.pot,
+	}
+	player.SaveAction(t.Round(), playerAction)
+	return nil
+}
+
+// resolveInsurance settles the insurance of the round's board card.
+func (t *Table) resolveInsurance() {
+	board := t.Board()
+	if len(board) == 0 {
+		return
+	}
+	card := board[len(board)-1]
+	for _, i := range t.insurance {
+		if i.Round != t.round || i.Settled {
+			continue
+		}
+		i.Settled = true
+		i.Hit = hand.NewCardSet(i.Outs...).Contains(card)
+		if i.Hit {
+			i.Payout = int(float64(i.Amount) * i.Odds)
+		}
+	}
+}
+
+// settleInsurance pays the insurance of the hand after the pots are paid
+// with the pot results and returns its results.  When an out hit but the
+// player won chips from the insured pot on a later card, the payout is
+// bought back.  Premiums were taken when bought, so they are only given
+// back with a payout.
+func (t *Table) settleInsurance(potResults map[int][]*Result) Results {
+	t.Lock()
+	defer t.Unlock()
+	results := map[int][]*Result{}
+	for _, i := range t.insurance {
+		if i.Amount == 0 || !i.Settled {
+			continue
+		}
+		if i.Hit {
+			for _, r := range potResults[i.Seat] {
+				if r.PotNo == i.PotNo && r.Chips > 0 {
+					i.BoughtBack = true
+				}
+			}
+		}
+		p := t.players[i.Seat]
+		r := &Result{PotNo: i.PotNo, Chips: i.Payout, Share: InsurancePayout}
+		if !i.Hit || i.BoughtBack {
+			r.Chips, r.Share = -i.Amount, InsurancePremium
+		} else if p != nil {
+			p.chips += i.Amount + i.Payout
+		}
+		results[i.Seat] = append(results[i.Seat], r)
+	}
+	return results
+}
@@ -0,0 +1,320 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/pokertest"
+)
+
+// insuranceTable returns a heads up hold'em table on the flop with seat 0
+// holding A♠ K♠ and 100 chips behind against Q♣ Q♦ all in.
+func insuranceTable() *Table {
+	opts := Config{
+		Game:       Holdem,
+		Stakes:     Stakes{SmallBet: 1, BigBet: 2},
+		NumOfSeats: 6,
+		Insurance:  true,
+	}
+	tbl := New(opts, hand.NewDealer())
+	holeCards := map[int][]*hand.Card{
+		0: pokertest.Cards("As", "Ks"),
+		1: pokertest.Cards("Qc", "Qd"),
+	}
+	for seat, cards := range holeCards {
+		p := &PlayerState{player: &player{id: int64(seat)}, allin: seat == 1}
+		if seat == 0 {
+			p.chips = 100
+		}
+		for _, card := range cards {
+			p.holeCards = append(p.holeCards, newHoleCard(card, Exposed))
+		}
+		tbl.players[seat] = p
+		tbl.pot.contribute(seat, 100)
+	}
+	tbl.boards = [][]*hand.Card{pokertest.Cards("Ah", "7c", "2d")}
+	tbl.round = int(flop)
+	tbl.showdown = true
+	return tbl
+}
+
+// deal deals the card of the next round and settles its insurance.
+func deal(tbl *Table, card string) {
+	tbl.round++
+	tbl.boards[0] = append(tbl.boards[0], pokertest.Cards(card)...)
+	tbl.resolveInsurance()
+}
+
+func TestInsuranceOffer(t *testing.T) {
+	t.Parallel()
+	tbl := insuranceTable()
+
+	if !tbl.startInsurance() || !tbl.Insuring() || tbl.Action() != 0 {
+		t.Fatalf("startInsurance() should offer seat 0 insurance")
+	}
+	offer := tbl.InsuranceOffer()
+	outs := hand.NewCardSet(offer.Outs...)
+	if outs.Count() != 2 || !outs.Contains(hand.QueenHearts) || !outs.Contains(hand.QueenSpades) {
+		t.Fatalf("offer outs = %v; want the two queens", offer.Outs)
+	}
+	if offer.Round != int(turn) || offer.Odds != 16 || offer.Max != 6 {
+		t.Fatalf("offer = %+v; want the turn at 16 to 1 for 0 to 6 chips", offer)
+	}
+	if actions := tbl.ValidActions(); len(actions) != 1 || actions[0] != Insure {
+		t.Fatalf("ValidActions() = %v; want Insure", actions)
+	}
+	if len(tbl.InsuranceLedger()) != 0 {
+		t.Fatalf("InsuranceLedger() = %v; want the offer left out", tbl.InsuranceLedger())
+	}
+
+	p := tbl.players[0]
+	if err := tbl.handleInsurance(p, Call, 0, false); err != ErrInvalidAction {
+		t.Fatalf("handleInsurance() error = %v; want %v", err, ErrInvalidAction)
+	}
+	for _, chips := range []int{-1, 7} {
+		if err := tbl.handleInsurance(p, Insure, chips, false); err != ErrInvalidInsurance {
+			t.Fatalf("handleInsurance(%d) error = %v; want %v", chips, err, ErrInvalidInsurance)
+		}
+	}
+	if err := tbl.handleInsurance(p, Insure, 6, false); err != nil {
+		t.Fatal(err)
+	}
+	if p.chips != 94 {
+		t.Fatalf("seat 0 chips = %d; want the 6 chip premium taken", p.chips)
+	}
+	tbl.insuring = false
+	if tbl.startInsurance() {
+		t.Fatal("startInsurance() should only offer insurance on the turn once")
+	}
+	if ledger := tbl.InsuranceLedger(); len(ledger) != 1 || ledger[0].Amount != 6 || ledger[0].Settled {
+		t.Fatalf("InsuranceLedger() = %+v; want 6 chips of unsettled insurance", ledger)
+	}
+}
+
+func TestInsuranceNotOffered(t *testing.T) {
+	t.Parallel()
+
+	// no outs
+	tbl := insuranceTable()
+	tbl.boards[0] = pokertest.Cards("Ah", "Ac", "Ad")
+	if tbl.startInsurance() {
+		t.Errorf("startInsurance() = %v; want no offer without outs", tbl.InsuranceOffer())
+	}
+
+	// more outs than the odds table
+	tbl = insuranceTable()
+	tbl.opts.InsuranceOdds = map[int]float64{1: 30}
+	if tbl.startInsurance() {
+		t.Errorf("startInsurance() = %v; want no offer without odds", tbl.InsuranceOffer())
+	}
+
+	// no one all in
+	tbl = insuranceTable()
+	tbl.players[1].allin = false
+	if tbl.startInsurance() {
+		t.Errorf("startInsurance() = %v; want no offer without a player all in", tbl.InsuranceOffer())
+	}
+
+	// the leader has no chips behind to pay the premium
+	tbl = insuranceTable()
+	tbl.players[0].chips = 0
+	if tbl.startInsurance() {
+		t.Errorf("startInsurance() = %v; want no offer without chips behind", tbl.InsuranceOffer())
+	}
+
+	// the river is dealt
+	tbl = insuranceTable()
+	tbl.round = int(river)
+	tbl.boards[0] = append(tbl.boards[0], pokertest.Cards("3c", "4d")...)
+	if tbl.startInsurance() {
+		t.Errorf("startInsurance() = %v; want no offer after the river", tbl.InsuranceOffer())
+	}
+}
+
+func TestInsurancePayout(t *testing.T) {
+	t.Parallel()
+	tbl := insuranceTable()
+
+	tbl.startInsurance()
+	if err := tbl.handleInsurance(tbl.players[0], Insure, 6, false); err != nil {
+		t.Fatal(err)
+	}
+	tbl.insuring = false
+
+	// the turn is an out and seat 0 is drawing dead, so seat 1 has
+	// nothing to insure
+	deal(tbl, "Qh")
+	ledger := tbl.InsuranceLedger()
+	if len(ledger) != 1 || !ledger[0].Settled || !ledger[0].Hit || ledger[0].Payout != 96 {
+		t.Fatalf("InsuranceLedger() = %+v; want the turn to pay 96 chips", ledger)
+	}
+	if tbl.startInsurance() {
+		t.Fatalf("startInsurance() = %v; want no offer against no outs", tbl.InsuranceOffer())
+	}
+	deal(tbl, "Ac")
+
+	// seat 1 wins the pot
+	results := tbl.settleInsurance(map[int][]*Result{1: {{PotNo: 0, Chips: 200, Share: WonHigh}}})
+	if len(results) != 1 || len(results[0]) != 1 {
+		t.Fatalf("settleInsurance() = %v; want one result for seat 0", results)
+	}
+	if r := results[0][0]; r.Chips != 96 || r.Share != InsurancePayout {
+		t.Fatalf("settleInsurance() seat 0 = %v; want a 96 chip payout", r)
+	}
+	if chips := tbl.players[0].chips; chips != 196 {
+		t.Fatalf("seat 0 chips = %d; want 196 with the premium given back", chips)
+	}
+}
+
+func TestInsuranceBuyBack(t *testing.T) {
+	t.Parallel()
+	won := map[int][]*Result{0: {{PotNo: 0, Chips: 200, Share: WonHigh}}}
+
+	// the turn is an out but seat 0 wins the pot anyway, so the payout
+	// is bought back and the premium lost
+	tbl := insuranceTable()
+	tbl.startInsurance()
+	if err := tbl.handleInsurance(tbl.players[0], Insure, 6, false); err != nil {
+		t.Fatal(err)
+	}
+	tbl.insuring = false
+	deal(tbl, "Qh")
+	deal(tbl, "5h")
+
+	tbl.players[0].chips += 200
+	results := tbl.settleInsurance(won)
+	if len(results[0]) != 1 || results[0][0].Chips != -6 || results[0][0].Share != InsurancePremium {
+		t.Fatalf("settleInsurance() seat 0 = %v; want the payout bought back for the 6 chip premium", results[0])
+	}
+	if ledger := tbl.InsuranceLedger(); len(ledger) != 1 || !ledger[0].Hit || !ledger[0].BoughtBack {
+		t.Fatalf("InsuranceLedger() = %+v; want the hit bought back", ledger)
+	}
+	if chips := tbl.players[0].chips; chips != 294 {
+		t.Fatalf("seat 0 chips = %d; want 294", chips)
+	}
+
+	// the turn misses so there is nothing to buy back and the river can
+	// be declined
+	tbl = insuranceTable()
+	tbl.startInsurance()
+	if err := tbl.handleInsurance(tbl.players[0], Insure, 6, false); err != nil {
+		t.Fatal(err)
+	}
+	tbl.insuring = false
+	deal(tbl, "3c")
+	if !tbl.startInsurance() {
+		t.Fatal("startInsurance() should offer seat 0 insurance on the river")
+	}
+	if offer := tbl.InsuranceOffer(); offer.Seat != 0 || offer.Max != 6 {
+		t.Fatalf("offer = %+v; want seat 0 to buy up to 6 chips", offer)
+	}
+	if err := tbl.handleInsurance(tbl.players[0], "", 0, true); err != nil {
+		t.Fatal(err)
+	}
+	tbl.insuring = false
+	deal(tbl, "5h")
+
+	tbl.players[0].chips += 200
+	results = tbl.settleInsurance(won)
+	if len(results[0]) != 1 || results[0][0].Chips != -6 || results[0][0].Share != InsurancePremium {
+		t.Fatalf("settleInsurance() seat 0 = %v; want the turn's 6 chip premium", results[0])
+	}
+	ledger := tbl.InsuranceLedger()
+	if len(ledger) != 2 || ledger[0].BoughtBack || ledger[1].Amount != 0 {
+		t.Fatalf("InsuranceLedger() = %+v; want the river declined and nothing bought back", ledger)
+	}
+}
+
+func TestInsurancePremiumFromChipsBehind(t *testing.T) {
+	t.Parallel()
+	tbl := insuranceTable()
+
+	// seat 0 has only 4 chips behind to pay the premium
+	tbl.players[0].chips = 4
+	if !tbl.startInsurance() {
+		t.Fatal("startInsurance() should offer seat 0 insurance")
+	}
+	if offer := tbl.InsuranceOffer(); offer.Max != 4 {
+		t.Fatalf("offer = %+v; want seat 0 to buy up to 4 chips", offer)
+	}
+	if err := tbl.handleInsurance(tbl.players[0], Insure, 5, false); err != ErrInvalidInsurance {
+		t.Fatalf("handleInsurance(5) error = %v; want %v", err, ErrInvalidInsurance)
+	}
+	if err := tbl.handleInsurance(tbl.players[0], Insure, 4, false); err != nil {
+		t.Fatal(err)
+	}
+	tbl.insuring = false
+	deal(tbl, "3c")
+	if tbl.startInsurance() {
+		t.Fatalf("startInsurance() = %v; want no offer after the chips behind are spent", tbl.InsuranceOffer())
+	}
+
+	results := tbl.settleInsurance(nil)
+	if total(results[0]) != -4 || tbl.players[0].chips != 0 {
+		t.Fatalf("settleInsurance() seat 0 = %v with %d chips left; want the 4 chip premium paid", results[0], tbl.players[0].chips)
+	}
+}
+
+// insurer is a player who buys the same premium whenever offered.
+type insurer struct {
+	player
+	premium int
+}
+
+func (p *insurer) Action() (Action, int, bool, bool) {
+	return Insure, p.premium, false, false
+}
+
+func TestInsuranceHand(t *testing.T) {
+	t.Parallel()
+	tbl := insuranceTable()
+	tbl.dealer = pokertest.Dealer(pokertest.Cards("3c", "5h"))
+	tbl.deck = tbl.dealer.Deck()
+	tbl.players[0].player = &insurer{player: player{id: 0}, premium: 6}
+	tbl.startedHand = true
+
+	var results map[int][]*Result
+	for i := 0; results == nil; i++ {
+		if i == 10 {
+			t.Fatal("the hand should be paid out at the showdown")
+		}
+		var err error
+		if results, _, err = tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if total(results[0]) != 200 {
+		t.Fatalf("results = %v; want seat 0 to win the 200 chip pot", results)
+	}
+	ledger := tbl.InsuranceLedger()
+	if len(ledger) != 2 || ledger[0].Round != int(turn) || ledger[1].Round != int(river) {
+		t.Fatalf("InsuranceLedger() = %+v; want the turn and the river insured", ledger)
+	}
+	insurance := tbl.InsuranceResults()
+	if len(insurance) != 1 || total(insurance[0]) != -12 {
+		t.Fatalf("InsuranceResults() = %v; want seat 0 to pay 12 chips of premiums", insurance)
+	}
+	if chips := tbl.players[0].chips; chips != 288 {
+		t.Fatalf("seat 0 chips = %d; want 288", chips)
+	}
+}
+
+func TestInsurancePanics(t *testing.T) {
+	t.Parallel()
+	for _, opts := range []Config{
+		{Game: StudHi, NumOfSeats: 6, Insurance: true},
+		{Game: OmahaHiLo, NumOfSeats: 6, Insurance: true},
+		{Game: ShortDeckHoldem, NumOfSeats: 6, Insurance: true},
+		{Game: Holdem, NumOfSeats: 6, Insurance: true, Boards: 2},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New(%+v) should panic", opts)
+				}
+			}()
+			New(opts, hand.NewDealer())
+		}()
+	}
+}
@@ -38,6 +38,20 @@ type Result struct {
 	Hand  *hand.Hand `json:"hand"`
 	Chips int        `json:"chips"`
 	Share Share      `json:"share"`
+
+	// Qualifier is the low qualifier of a split pot game, which explains
+	// a split pot won without a low.  It is empty for other games.
+	Qualifier Qualifier `json:"qualifier,omitempty"`
+
+	// Board is the number of the board, starting from one, that the
+	// share of the pot was won on in double board games.  It is zero for
+	// other games.
+	Board int `json:"board,omitempty"`
+
+	// Run is the number of the run, starting from one, that the share of
+	// the pot was won on when the board was run more than once.  It is
+	// zero otherwise.
+	Run int `json:"run,omitempty"`
 }
 
 // String returns a string useful for debugging.
@@ -49,26 +63,42 @@ func (p *Result) String() string {
 // MarshalJSON implements the json.Marshaler interface.
 // The json format is:
 // {"hand": {"ranking":9,"cards":["A♠","K♠","Q♠","J♠","T♠"],"description":"royal flush"}, "chips": 4, "share": "WonHigh"}
+// The board, run and qualifier are only included when they are set.
 func (r *Result) MarshalJSON() ([]byte, error) {
 	b, err := r.Hand.MarshalJSON()
 	if err != nil {
 		return []byte{}, err
 	}
-	const format = `{"hand":%v,"chips":%v,"share":"%v"}`
+	const format = `{"hand":%v,"chips":%v,"share":"%v"`
 	s := fmt.Sprintf(format, string(b), r.Chips, r.Share)
-	return []byte(s), nil
+	if r.Board != 0 {
+		s += fmt.Sprintf(`,"board":%v`, r.Board)
+	}
+	if r.Run != 0 {
+		s += fmt.Sprintf(`,"run":%v`, r.Run)
+	}
+	if r.Qualifier != "" {
+		s += fmt.Sprintf(`,"qualifier":"%v"`, r.Qualifier)
+	}
+	return []byte(s + "}"), nil
 }
 
 type ResultJSON struct {
-	Hand  hand.HandJSON `json:"hand"`
-	Chips int           `json:"chips"`
-	Share Share         `json:"share"`
+	Hand      hand.HandJSON `json:"hand"`
+	Chips     int           `json:"chips"`
+	Share     Share         `json:"share"`
+	Qualifier Qualifier     `json:"qualifier,omitempty" bson:"qualifier,omitempty"`
+	Board     int           `json:"board,omitempty" bson:"board,omitempty"`
+	Run       int           `json:"run,omitempty" bson:"run,omitempty"`
 }
 
 func (r *Result) ResultJSON() ResultJSON {
 	resultJSON := ResultJSON{
-		Chips: r.Chips,
-		Share: r.Share,
+		Chips:     r.Chips,
+		Share:     r.Share,
+		Qualifier: r.Qualifier,
+		Board:     r.Board,
+		Run:       r.Run,
 	}
 	if r.Hand != nil {
 		resultJSON.Hand = r.Hand.HandJSON()
@@ -156,9 +186,11 @@ func (p *Pot) take(seat int) Results {
 	return results
 }
 
-// payout takes the high and low hands to produce pot results.
-// Sorting determines how a non-split pot winning hands are sorted.
-func (p *Pot) payout(potNo int, t *Table, highHands, lowHands Hands, sorting hand.Sorting, button int) Results {
+// payout takes the high and low hands of each board to produce pot
+// results.  Each pot is split evenly between the boards with the odd
+// chips going to the first boards.  Sorting determines how a non-split
+// pot winning hands are sorted.
+func (p *Pot) payout(potNo int, t *Table, highHands, lowHands []Hands, sorting hand.Sorting, button int) Results {
 	sidePots := p.SidePots(t.GetPlayerBeginChips())
 	if len(sidePots) > 1 {
 		results := map[int][]*Result{}
@@ -169,6 +201,34 @@ func (p *Pot) payout(potNo int, t *Table, highHands, lowHands Hands, sorting han
 		return results
 	}
 
+	results := map[int][]*Result{}
+	chips := p.Chips()
+	for board, highs := range highHands {
+		amount := chips / len(highHands)
+		if board < chips%len(highHands) {
+			amount++
+		}
+		var lows Hands
+		if board < len(lowHands) {
+			lows = lowHands[board]
+		}
+		r := p.payoutBoard(potNo, t, highs, lows, amount, sorting, button)
+		if len(highHands) > 1 {
+			for _, rs := range r {
+				for _, result := range rs {
+					result.Board = board + 1
+				}
+			}
+		}
+		results = combineResults(results, r)
+	}
+	return results
+}
+
+// payoutBoard pays the chips of the pot's share of a board to the high
+// and low hands formed with the board.  The high hand wins the odd chip
+// of a split pot.
+func (p *Pot) payoutBoard(potNo int, t *Table, highHands, lowHands Hands, chips int, sorting hand.Sorting, button int) Results {
 	sideHighHands := highHands.handsForSeats(p.seats())
 	sideLowHands := lowHands.handsForSeats(p.seats())
 
@@ -177,9 +237,9 @@ func (p *Pot) payout(potNo int, t *Table, highHands, lowHands Hands, sorting han
 		winners := sideHighHands.winningHands(sorting)
 		switch sorting {
 		case hand.SortingHigh:
-			return p.resultsFromWinners(potNo, winners, p.Chips(), button, highPotShare)
+			return p.resultsFromWinners(potNo, winners, chips, button, highPotShare)
 		case hand.SortingLow:
-			return p.resultsFromWinners(potNo, winners, p.Chips(), button, lowPotShare)
+			return p.resultsFromWinners(potNo, winners, chips, button, lowPotShare)
 		}
 	}
 
@@ -187,19 +247,11 @@ func (p *Pot) payout(potNo int, t *Table, highHands, lowHands Hands, sorting han
 	lowWinners := sideLowHands.winningHandsForHoldem(t, hand.SortingLow)
 
 	if len(lowWinners) == 0 {
-		return p.resultsFromWinners(potNo, highWinners, p.Chips(), button, highPotShare)
-	}
-
-	highResults := map[int][]*Result{}
-	lowResults := map[int][]*Result{}
-
-	highAmount := p.Chips() / 2
-	if highAmount%2 == 1 {
-		highAmount++
+		return p.resultsFromWinners(potNo, highWinners, chips, button, highPotShare)
 	}
 
-	highResults = p.resultsFromWinners(potNo, highWinners, highAmount, button, highPotShare)
-	lowResults = p.resultsFromWinners(potNo, lowWinners, p.Chips()/2, button, lowPotShare)
+	highResults := p.resultsFromWinners(potNo, highWinners, chips-chips/2, button, highPotShare)
+	lowResults := p.resultsFromWinners(potNo, lowWinners, chips/2, button, lowPotShare)
 	return combineResults(highResults, lowResults)
 }
 
@@ -16,17 +16,13 @@ var (
 	}
 
 	omahaHiFunc = func(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
-		opts := func(c *hand.Config) {}
-		hands := omahaHands(holeCards, board, opts)
-		hands = hand.Sort(hand.SortingHigh, hand.DESC, hands...)
-		return hands[0]
+		return hand.ExactlyTwo.Hand(holeCards, board)
 	}
 
 	omahaLoFunc = func(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
-		hands := omahaHands(holeCards, board, hand.AceToFiveLow)
-		hands = hand.Sort(hand.SortingLow, hand.DESC, hands...)
-		if hands[0].CompareTo(eightOrBetter) <= 0 {
-			return hands[0]
+		low := hand.ExactlyTwo.Hand(holeCards, board, hand.AceToFiveLow)
+		if EightOrBetter.qualifies(low) {
+			return low
 		}
 		return nil
 	}
@@ -181,6 +177,29 @@ func TestPotJSON(t *testing.T) {
 	}
 }
 
+func TestResultJSON(t *testing.T) {
+	t.Parallel()
+
+	cards := pokertest.Cards("As", "2s", "3d", "4s", "5s", "Kc", "Kd")
+	// a result on the first board of a double board game
+	r := &Result{Hand: hand.New(cards, hand.AceToFiveLow), Chips: 10, Share: WonLow, Board: 1}
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rCopy := &Result{}
+	if err := json.Unmarshal(b, rCopy); err != nil {
+		t.Fatal(err)
+	}
+	if rCopy.Hand.String() != r.Hand.String() || rCopy.Hand.CompareTo(r.Hand) != 0 {
+		t.Errorf("after json roundtrip hand = %v; want %v", rCopy.Hand, r.Hand)
+	}
+	if rCopy.Board != 1 {
+		t.Errorf("after json roundtrip board = %d; want %d from %s", rCopy.Board, 1, b)
+	}
+}
+
 func TestHighPot(t *testing.T) {
 	t.Parallel()
 	tbl := holdemTable()
@@ -207,7 +226,7 @@ func TestHighPot(t *testing.T) {
 
 	board := pokertest.Cards("Ad", "Kd", "Qd", "2d", "2h")
 	hands := newHands(seatToHoleCards, board, holdemFunc)
-	payout := p.payout(0, tbl, hands, nil, hand.SortingHigh, 0)
+	payout := p.payout(0, tbl, []Hands{hands}, nil, hand.SortingHigh, 0)
 	for seat, results := range payout {
 		switch seat {
 		case 0:
@@ -272,7 +291,7 @@ func TestHighLowPot(t *testing.T) {
 	board := pokertest.Cards("7s", "Kd", "8h", "Jh", "5c")
 	highHands := newHands(seatToHoleCards, board, omahaHiFunc)
 	lowHands := newHands(seatToHoleCards, board, omahaLoFunc)
-	payout := p.payout(0, tbl, highHands, lowHands, hand.SortingHigh, 0)
+	payout := p.payout(0, tbl, []Hands{highHands}, []Hands{lowHands}, hand.SortingHigh, 0)
 
 	if len(payout) < 3 {
 		t.Errorf("pot.Payout() should have 3 results")
@@ -344,7 +363,7 @@ func TestOmahaHiPot(t *testing.T) {
 	board := pokertest.Cards("8c", "Kc", "Qc", "4c", "5c")
 	highHands := newHands(seatToHoleCards, board, omahaHiFunc)
 	fmt.Println(highHands)
-	payout := p.payout(0, tbl, highHands, nil, hand.SortingHigh, 0)
+	payout := p.payout(0, tbl, []Hands{highHands}, nil, hand.SortingHigh, 0)
 
 	// if len(payout) != 2 {
 	// 	t.Errorf("pot.Payout() should have 2 results")
@@ -406,10 +425,120 @@ func TestMultiWinnerPot(t *testing.T) {
 
 	board := pokertest.Cards("Ac", "Kd", "Qd", "3c", "2h")
 	hands := newHands(seatToHoleCards, board, holdemFunc)
-	payout := p.payout(0, tbl, hands, nil, hand.SortingHigh, 2)
+	payout := p.payout(0, tbl, []Hands{hands}, nil, hand.SortingHigh, 2)
 	for seat, results := range payout {
 
 		fmt.Println("seat:", seat)
 		fmt.Println("results:", results)
 	}
 }
+
+func TestBadugiPot(t *testing.T) {
+	t.Parallel()
+	tbl := holdemTable()
+
+	p := newPot(3)
+	p.contribute(0, 10)
+	p.contribute(1, 10)
+	p.contribute(2, 10)
+
+	seatToHoleCards := map[int][]*hand.Card{
+		0: pokertest.Cards("Ks", "Qh", "Jd", "Tc"),
+		1: pokertest.Cards("4s", "3h", "2d", "2c"),
+		2: pokertest.Cards("7s", "5h", "3d", "2c"),
+	}
+	badugiFunc := func(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
+		return hand.New(holeCards, hand.Badugi)
+	}
+	hands := newHands(seatToHoleCards, nil, badugiFunc)
+	payout := p.payout(0, tbl, []Hands{hands}, nil, hand.SortingLow, 0)
+	if len(payout[2]) != 1 || payout[2][0].Chips != 30 {
+		t.Fatalf("seat 2 results = %v; want the 30 chip pot", payout[2])
+	}
+	if len(payout[0]) != 0 || len(payout[1]) != 0 {
+		t.Fatalf("payout = %v; want only seat 2 to win", payout)
+	}
+}
+
+func TestDoubleBoardPot(t *testing.T) {
+	t.Parallel()
+	tbl := holdemTable()
+
+	p := newPot(3)
+	p.contribute(0, 5)
+	p.contribute(1, 5)
+	p.contribute(2, 5)
+
+	seatToHoleCards := map[int][]*hand.Card{
+		0: pokertest.Cards("As", "Ah"),
+		1: pokertest.Cards("7s", "7h"),
+		2: pokertest.Cards("Ks", "Qh"),
+	}
+	boards := [][]*hand.Card{
+		pokertest.Cards("Ad", "Jd", "9c", "4h", "2s"),
+		pokertest.Cards("7d", "8c", "Tc", "3h", "2d"),
+	}
+	hands := []Hands{}
+	for _, board := range boards {
+		hands = append(hands, newHands(seatToHoleCards, board, holdemFunc))
+	}
+
+	// the first board wins the odd chip
+	payout := p.payout(0, tbl, hands, nil, hand.SortingHigh, 0)
+	if rs := payout[0]; len(rs) != 1 || rs[0].Chips != 8 || rs[0].Board != 1 {
+		t.Errorf("seat 0 results = %v; want 8 chips on board 1", rs)
+	}
+	if rs := payout[1]; len(rs) != 1 || rs[0].Chips != 7 || rs[0].Board != 2 {
+		t.Errorf("seat 1 results = %v; want 7 chips on board 2", rs)
+	}
+	if len(payout[2]) != 0 {
+		t.Errorf("seat 2 results = %v; want none", payout[2])
+	}
+}
+
+func TestDoubleBoardHighLowPot(t *testing.T) {
+	t.Parallel()
+	tbl := omahaHiTable()
+	for seat := 0; seat < 2; seat++ {
+		tbl.players[seat] = &PlayerState{player: &player{id: int64(seat)}}
+	}
+
+	p := newPot(2)
+	p.contribute(0, 10)
+	p.contribute(1, 10)
+
+	seatToHoleCards := map[int][]*hand.Card{
+		0: pokertest.Cards("As", "2h", "Kd", "Kc"),
+		1: pokertest.Cards("Qs", "Qh", "9d", "9c"),
+	}
+	boards := [][]*hand.Card{
+		// seat 0 scoops with a wheel
+		pokertest.Cards("3d", "4c", "5s", "Jh", "Td"),
+		// seat 1 wins the high and there is no low
+		pokertest.Cards("Qd", "9s", "Jc", "7s", "8h"),
+	}
+	highHands := []Hands{}
+	lowHands := []Hands{}
+	for _, board := range boards {
+		highHands = append(highHands, newHands(seatToHoleCards, board, omahaHiFunc))
+		lowHands = append(lowHands, newHands(seatToHoleCards, board, omahaLoFunc))
+	}
+
+	payout := p.payout(0, tbl, highHands, lowHands, hand.SortingHigh, 0)
+	want := map[int]map[Share]int{
+		0: {WonHigh: 5, WonLow: 5},
+		1: {WonHigh: 10},
+	}
+	for seat, shares := range want {
+		got := map[Share]int{}
+		for _, r := range payout[seat] {
+			if r.Board != seat+1 {
+				t.Errorf("seat %d result %v on board %d; want board %d", seat, r, r.Board, seat+1)
+			}
+			got[r.Share] += r.Chips
+		}
+		if fmt.Sprint(got) != fmt.Sprint(shares) {
+			t.Errorf("seat %d shares = %v; want %v", seat, got, shares)
+		}
+	}
+}
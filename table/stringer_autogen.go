@@ -4,9 +4,9 @@ package table
 
 import "fmt"
 
-const _Game_name = "HoldemOmahaHiOmahaHiLoRazzStudHiStudHiLo"
+const _Game_name = "HoldemOmahaHiOmahaHiLoRazzStudHiStudHiLoShortDeckHoldemOmahaHi5OmahaHiLo5OmahaHi6RoyalHoldemFiveCardDrawDeuceToSevenSingleDrawDeuceToSevenTripleDrawAceToFiveTripleDrawBadugiPineappleCrazyPineapple"
 
-var _Game_index = [...]uint8{6, 13, 22, 26, 32, 40}
+var _Game_index = [...]uint8{6, 13, 22, 26, 32, 40, 55, 63, 73, 81, 92, 104, 126, 148, 167, 173, 182, 196}
 
 func (i Game) String() string {
 	i -= 1
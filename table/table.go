@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/rolends1986/poker/hand"
+	"github.com/rolends1986/poker/util"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -53,6 +54,23 @@ var (
 	// ErrInvalidAction errors occur when a player attempts an action that isn't
 	// currently allowed.  For example a check action is invalid when faced with a raise.
 	ErrInvalidAction = errors.New("table: player attempted invalid action")
+
+	// ErrInvalidDiscard errors occur when a player attempts to discard
+	// cards that aren't in his or her hand or more cards than the game
+	// allows.
+	ErrInvalidDiscard = errors.New("table: player attempted invalid discard")
+
+	// ErrInsufficientCards errors occur when a player attempts to draw
+	// more cards than are left in the stub and the discards.
+	ErrInsufficientCards = errors.New("table: player attempted drawing more cards than are left")
+
+	// ErrInvalidRuns errors occur when a player votes to run the board
+	// fewer than once or more times than the table allows.
+	ErrInvalidRuns = errors.New("table: player attempted invalid runs vote")
+
+	// ErrInvalidInsurance errors occur when a player attempts to buy less
+	// or more insurance than offered.
+	ErrInvalidInsurance = errors.New("table: player attempted invalid insurance")
 )
 
 type StraddleCategory uint8
@@ -241,7 +259,7 @@ func (state *PlayerState) UnmarshalJSON(b []byte) error {
 
 	p, err := registeredPlayer.FromID(tpJSON.ID)
 	if err != nil {
-		return fmt.Errorf("table PlayerState json deserialization failed because of player %s FromID - %s", tpJSON.ID, err)
+		return fmt.Errorf("table PlayerState json deserialization failed because of player %d FromID - %s", tpJSON.ID, err)
 	}
 
 	state.player = p
@@ -272,14 +290,23 @@ type Table struct {
 	utgSeat       int // 枪口位
 	action        int
 	round         int
-	minRaise      int // 玩家加注值=下注值-roundPot-outstanding
-	board         []*hand.Card
+	minRaise      int            // 玩家加注值=下注值-roundPot-outstanding
+	boards        [][]*hand.Card // 公共牌，双公共牌局有两副
 	players       map[int]*PlayerState
 	pot           *Pot
 	sidePots      []*Pot
 	startedHand   bool
 	showdown      bool            // 是否可以摊牌
+	drawing       bool            // 是否在换牌或弃牌
+	betAction     int             // 换牌或弃牌后第一个下注的座位
+	voting        bool            // 是否在投票决定发几次
+	runs          int             // 剩余公共牌发几次，0为未决定
+	insuring      bool            // 是否在购买保险
+	insurance     []*Insurance    // 本手保险记录
+	settlements   Results         // 上一手保险结算
+	muck          []*hand.Card    // 换牌弃掉的牌和烧牌
 	straddleSeats []*StraddleSeat // 本轮straddle位
+	reveal        *hand.Reveal    // 可证明公平的发牌信息
 	sync.RWMutex  `bson:"-" json:"-"`
 }
 
@@ -294,16 +321,56 @@ func New(opts Config, dealer hand.Dealer) *Table {
 		panic(s)
 	}
 
-	return &Table{
+	if !opts.Qualifier.valid() {
+		panic(fmt.Sprintf("table: %q isn't a valid qualifier", opts.Qualifier))
+	}
+
+	if opts.Boards < 0 || opts.Boards > 2 {
+		panic(fmt.Sprintf("table: %d isn't a valid number of boards", opts.Boards))
+	}
+	if opts.Boards == 2 {
+		g, ok := opts.Game.get().(*holdemGame)
+		if !ok {
+			panic(fmt.Sprintf("table: %s can't be played with a double board", opts.Game))
+		}
+		if int(opts.NumOfSeats) > g.maxSeats(2) {
+			format := "table: double board %s has a maximum of %d seats but attempted %d"
+			panic(fmt.Sprintf(format, opts.Game, g.maxSeats(2), opts.NumOfSeats))
+		}
+	}
+
+	if opts.MaxRuns < 0 {
+		panic(fmt.Sprintf("table: %d isn't a valid maximum number of runs", opts.MaxRuns))
+	}
+	if opts.MaxRuns > 1 {
+		g, ok := opts.Game.get().(*holdemGame)
+		if !ok || opts.Boards == 2 {
+			panic(fmt.Sprintf("table: %s can't run the board more than once", opts.Game))
+		}
+		if int(opts.NumOfSeats) > g.maxSeats(opts.MaxRuns) {
+			format := "table: %s run %d times has a maximum of %d seats but attempted %d"
+			panic(fmt.Sprintf(format, opts.Game, opts.MaxRuns, g.maxSeats(opts.MaxRuns), opts.NumOfSeats))
+		}
+	}
+
+	if opts.Insurance {
+		g, ok := opts.Game.get().(*holdemGame)
+		if !ok || g.Split || g.Discards > 0 || len(g.DeckOptions()) > 0 || opts.Boards == 2 {
+			panic(fmt.Sprintf("table: %s can't be played with insurance", opts.Game))
+		}
+	}
+
+	t := &Table{
 		opts:          opts,
 		dealer:        dealer,
-		deck:          dealer.Deck(),
-		board:         []*hand.Card{},
+		boards:        newBoards(opts),
 		players:       map[int]*PlayerState{},
 		pot:           newPot(int(opts.NumOfSeats)),
 		action:        -1,
 		straddleSeats: []*StraddleSeat{},
+		deck:          &hand.Deck{Cards: []*hand.Card{}},
 	}
+	return t
 }
 
 // 获取玩家的 beginChips
@@ -343,15 +410,28 @@ func (t *Table) Action() int {
 
 // Board returns the current community cards.  An empty slice is
 // returned if there are no community cards or the game doesn't
-// support community cards.
+// support community cards.  In double board games it is the first
+// board.
 func (t *Table) Board() []*hand.Card {
 	c := []*hand.Card{}
-	return append(c, t.board...)
+	return append(c, t.boards[0]...)
+}
+
+// Boards returns the current community cards of every board.  There
+// is one board unless the table is configured with a double board or
+// the board is run more than once, in which case there is a board for
+// each run.
+func (t *Table) Boards() [][]*hand.Card {
+	boards := [][]*hand.Card{}
+	for _, board := range t.boards {
+		boards = append(boards, append([]*hand.Card{}, board...))
+	}
+	return boards
 }
 
 func (t *Table) BoardString() []string {
 	c := []string{}
-	for _, item := range t.board {
+	for _, item := range t.boards[0] {
 		c = append(c, item.String())
 	}
 	return c
@@ -390,6 +470,29 @@ func (t *Table) Game() Game {
 	return t.opts.Game
 }
 
+// Qualifier returns the low hand qualifier of the table's split pots.
+func (t *Table) Qualifier() Qualifier {
+	return t.opts.Qualifier.orDefault()
+}
+
+// EquityOptions returns the hand.CalcEquity options that evaluate hands
+// like the table's game, including the qualifier of its split pots.  It
+// is nil for stud and draw games, which don't have a board.
+func (t *Table) EquityOptions() []func(*hand.EquityConfig) {
+	g, ok := t.game().(*holdemGame)
+	if !ok {
+		return nil
+	}
+	options := []func(*hand.EquityConfig){}
+	if g.IsOmaha {
+		options = append(options, hand.EquityOmaha)
+		if g.Split {
+			options = append(options, hand.EquityHiLo, t.Qualifier().equityOption())
+		}
+	}
+	return options
+}
+
 // Limit returns the limit of the table.
 func (t *Table) Limit() Limit {
 	return t.opts.Limit
@@ -588,14 +691,20 @@ func (t *Table) View(p Player) *Table {
 		action:       t.action,
 		round:        t.round,
 		minRaise:     t.minRaise,
-		board:        t.board,
+		boards:       t.boards,
 		pot:          t.pot,
 		sidePots:     t.sidePots,
 		startedHand:  t.startedHand,
+		drawing:      t.drawing,
+		voting:       t.voting,
+		runs:         t.runs,
+		insuring:     t.insuring,
+		insurance:    t.insurance,
 		players:      players,
 		smallBetSeat: t.smallBetSeat,
 		bigBetSeat:   t.bigBetSeat,
 		utgSeat:      t.utgSeat,
+		reveal:       t.viewOfReveal(),
 	}
 }
 
@@ -632,14 +741,20 @@ func (t *Table) LookerView() *Table {
 		action:       t.action,
 		round:        t.round,
 		minRaise:     t.minRaise,
-		board:        t.board,
+		boards:       t.boards,
 		pot:          t.pot,
 		sidePots:     t.sidePots,
 		startedHand:  t.startedHand,
+		drawing:      t.drawing,
+		voting:       t.voting,
+		runs:         t.runs,
+		insuring:     t.insuring,
+		insurance:    t.insurance,
 		players:      players,
 		smallBetSeat: t.smallBetSeat,
 		bigBetSeat:   t.bigBetSeat,
 		utgSeat:      t.utgSeat,
+		reveal:       t.viewOfReveal(),
 	}
 }
 
@@ -697,18 +812,49 @@ func (t *Table) Stakes() Stakes {
 
 // String returns a string useful for debugging.
 func (t *Table) String() string {
-	const format = "{Button: Seat %d, Current Player: %v, Round %d, Board: %s, Pot: %d}\n"
+	const format = "{Button: Seat %d, Current Player: %v, Round %d, Boards: %s, Pot: %d}\n"
 	var current int64 = 0
 	if t.action != -1 && !isNil(t.CurrentPlayer()) {
 		current = t.CurrentPlayer().player.ID()
 	}
 
-	return fmt.Sprintf(format, t.button, current, t.round, t.board, t.pot.Chips())
+	return fmt.Sprintf(format, t.button, current, t.round, t.boards, t.pot.Chips())
+}
+
+// Drawing returns whether the current player is drawing or discarding
+// rather than betting.
+func (t *Table) Drawing() bool {
+	return t.drawing
+}
+
+// Voting returns whether the players left all in are voting how many
+// times to run the rest of the board.
+func (t *Table) Voting() bool {
+	return t.voting
+}
+
+// Runs returns how many times the rest of the board is run.  It is one
+// unless the players left all in have voted to run it more.
+func (t *Table) Runs() int {
+	if t.voting || t.runs == 0 {
+		return 1
+	}
+	return t.runs
 }
 
 // ValidActions returns the actions that can be taken by the current
 // player.
 func (t *Table) ValidActions() []Action {
+	if t.voting {
+		return []Action{Run}
+	}
+	if t.insuring {
+		return []Action{Insure}
+	}
+	if t.drawing {
+		return []Action{t.drawRule().action()}
+	}
+
 	player := t.CurrentPlayer()
 	if player.AllIn() || player.Out() {
 		return []Action{}
@@ -769,15 +915,26 @@ func (t *Table) Next() (results map[int][]*Result, done bool, err error) {
 	}
 
 	if t.action == -1 {
+		if t.startVote() || t.startInsurance() {
+			return nil, false, nil
+		}
+
 		t.round++
 		t.resetRoundPot()
 
 		if t.round == t.game().NumOfRounds() {
 			holeCards := cardsFromHoleCardMap(t.HoleCards())
-			highHands := newHands(holeCards, t.board, t.game().FormHighHand)
-			lowHands := newHands(holeCards, t.board, t.game().FormLowHand)
+			highHands := []Hands{}
+			lowHands := []Hands{}
+			for _, board := range t.boards {
+				highHands = append(highHands, newHands(holeCards, board, t.game().FormHighHand))
+				lowHands = append(lowHands, newHands(holeCards, board, t.formLowHand))
+			}
 			results = t.pot.payout(0, t, highHands, lowHands, t.game().Sorting(), t.button)
+			t.recordQualifier(results)
+			t.recordRuns(results)
 			t.payoutResults(results)
+			t.settlements = t.settleInsurance(results)
 			t.startedHand = false
 			t.action = -1
 			t.showHoleCards()
@@ -785,11 +942,46 @@ func (t *Table) Next() (results map[int][]*Result, done bool, err error) {
 		}
 
 		t.setUpRound()
+		t.resolveInsurance()
 		return nil, false, nil
 	}
 
 	current := t.CurrentPlayer()
 	action, chips, timeout, ignore := current.player.Action()
+	if t.insuring {
+		// an ignored player declines the insurance
+		if err := t.handleInsurance(current, action, chips, timeout || ignore); err != nil {
+			return nil, false, err
+		}
+		t.insuring = false
+		t.action = -1
+		return nil, false, nil
+	}
+	if t.voting {
+		// an ignored player votes to run it once
+		if err := t.handleVote(current, action, chips, timeout || ignore); err != nil {
+			return nil, false, err
+		}
+		current.acted = true
+		t.action = t.nextVoteSeat(t.action + 1)
+		if t.action == -1 {
+			t.endVote()
+		}
+		return nil, false, nil
+	}
+	if t.drawing {
+		// an ignored player makes the default discard
+		if err := t.handleDraw(t.action, current, action, timeout || ignore); err != nil {
+			return nil, false, err
+		}
+		current.acted = true
+		t.action = t.nextDrawSeat(t.action + 1)
+		if t.action == -1 {
+			t.endDraw()
+		}
+		return nil, false, nil
+	}
+
 	if !ignore {
 		if err := t.handleAction(t.action, current, action, chips, timeout); err != nil {
 			return nil, false, err
@@ -944,13 +1136,23 @@ type tableJSON struct {
 	Round        int                     `json:"round" bson:"round"`
 	MinRaise     int                     `json:"minRaise" bson:"minRaise"`
 	Board        []*hand.Card            `json:"board" bson:"board"`
+	Boards       [][]*hand.Card          `json:"boards,omitempty" bson:"boards,omitempty"`
 	Players      map[string]*PlayerState `json:"players" bson:"players"`
 	Pot          *Pot                    `json:"pot" bson:"pot"`
 	SidePots     []*Pot                  `json:"sidePots" bson:"sidePots"`
 	StartedHand  bool                    `json:"startedHand" bson:"startedHand"`
+	Drawing      bool                    `json:"drawing,omitempty" bson:"drawing,omitempty"`
+	Voting       bool                    `json:"voting,omitempty" bson:"voting,omitempty"`
+	Runs         int                     `json:"runs,omitempty" bson:"runs,omitempty"`
+	Insuring     bool                    `json:"insuring,omitempty" bson:"insuring,omitempty"`
+	Insurance    []*Insurance            `json:"insurance,omitempty" bson:"insurance,omitempty"`
+	BetAction    int                     `json:"betAction,omitempty" bson:"betAction,omitempty"`
+	Muck         []*hand.Card            `json:"muck,omitempty" bson:"muck,omitempty"`
 	SmallBetSeat int                     `json:"smallBetSeat" bson:"smallBetSeat"`
 	BigBetSeat   int                     `json:"bigBetSeat" bson:"bigBetSeat"`
 	UtgSeat      int                     `json:"utgSeat" bson:"utgSeat"`
+	Commitment   string                  `json:"commitment,omitempty" bson:"commitment,omitempty"`
+	Reveal       *hand.Reveal            `json:"reveal,omitempty" bson:"reveal,omitempty"`
 }
 
 // MarshalJSON implements the json.Marshaler interface.
@@ -968,13 +1170,23 @@ func (t *Table) MarshalJSON() ([]byte, error) {
 		Round:        t.Round(),
 		MinRaise:     t.MinRaise(),
 		Board:        t.Board(),
+		Boards:       t.doubleBoards(),
 		Players:      players,
 		Pot:          t.Pot(),
 		SidePots:     t.sidePots,
 		StartedHand:  t.startedHand,
+		Drawing:      t.drawing,
+		Voting:       t.voting,
+		Runs:         t.runs,
+		Insuring:     t.insuring,
+		Insurance:    t.insurance,
+		BetAction:    t.betAction,
+		Muck:         t.muck,
 		SmallBetSeat: t.smallBetSeat,
 		BigBetSeat:   t.bigBetSeat,
 		UtgSeat:      t.utgSeat,
+		Commitment:   t.Commitment(),
+		Reveal:       t.reveal,
 	}
 	return json.Marshal(tJSON)
 }
@@ -1002,28 +1214,125 @@ func (t *Table) UnmarshalJSON(b []byte) error {
 	t.action = tJSON.Action
 	t.round = tJSON.Round
 	t.minRaise = tJSON.MinRaise
-	t.board = tJSON.Board
+	t.boards = tJSON.Boards
+	if len(t.boards) == 0 {
+		t.boards = [][]*hand.Card{tJSON.Board}
+	}
 	t.players = players
 	t.pot = tJSON.Pot
 	t.sidePots = tJSON.SidePots
 	t.startedHand = tJSON.StartedHand
+	t.drawing = tJSON.Drawing
+	t.voting = tJSON.Voting
+	t.runs = tJSON.Runs
+	t.insuring = tJSON.Insuring
+	t.insurance = tJSON.Insurance
+	t.betAction = tJSON.BetAction
+	t.muck = tJSON.Muck
 	t.smallBetSeat = tJSON.SmallBetSeat
 	t.bigBetSeat = tJSON.BigBetSeat
 	t.utgSeat = tJSON.UtgSeat
+	// the reveal is kept during the hand so a reloaded table can still
+	// reveal the deck once the hand is over
+	t.reveal = tJSON.Reveal
+	if t.reveal == nil && tJSON.Commitment != "" {
+		t.reveal = &hand.Reveal{Commitment: tJSON.Commitment}
+	}
 
 	return nil
 }
 
+// newDeck returns a deck from the dealer with only the cards used by
+// the game.  If the dealer is a *hand.FairDealer its reveal of the deck
+// is kept until the hand is over.
+func (t *Table) newDeck() *hand.Deck {
+	deck := t.dealer.Deck()
+	deck.Reduce(t.game().DeckOptions()...)
+	for _, card := range deck.Cards {
+		// jokers can't be told apart, so discards and outs can't be
+		// tracked with them
+		if card.IsJoker() {
+			panic("table: dealer dealt a joker, which tables don't support")
+		}
+	}
+	t.reveal = nil
+	if fd, ok := t.dealer.(*hand.FairDealer); ok {
+		r := fd.Reveal()
+		t.reveal = &r
+	}
+	return deck
+}
+
+// Commitment returns the provably fair commitment of the deck of the hand
+// in progress.  Between hands it is the commitment of the next hand's
+// deck, which must be published before players add client seeds to the
+// dealer.  It is empty unless the table's dealer is a *hand.FairDealer.
+func (t *Table) Commitment() string {
+	if !t.startedHand {
+		if fd, ok := t.dealer.(*hand.FairDealer); ok {
+			return fd.Commitment()
+		}
+	}
+	if t.reveal == nil {
+		return ""
+	}
+	return t.reveal.Commitment
+}
+
+// Reveal returns the material to verify the last hand's deck with
+// VerifyDeck.  It is nil while a hand is in progress or unless the
+// table's dealer is a *hand.FairDealer.
+func (t *Table) Reveal() *hand.Reveal {
+	if t.startedHand || t.reveal == nil || t.reveal.ServerSeed == "" {
+		return nil
+	}
+	r := *t.reveal
+	r.ClientSeeds = append([]string{}, t.reveal.ClientSeeds...)
+	return &r
+}
+
+// viewOfReveal returns the reveal for a view of the table.  During a hand
+// only the commitment is shown, since the server seed gives away the deck.
+func (t *Table) viewOfReveal() *hand.Reveal {
+	if t.reveal == nil {
+		return nil
+	}
+	if r := t.Reveal(); r != nil {
+		return r
+	}
+	return &hand.Reveal{Commitment: t.reveal.Commitment}
+}
+
+// VerifyDeck recomputes the deck of a hand of the game from the reveal of
+// a *hand.FairDealer created with the options.  The deck is reduced to the
+// cards the game uses, such as the 36 cards of ShortDeckHoldem, so it is
+// in the order the table dealt from.
+func VerifyDeck(g Game, r hand.Reveal, options ...func(*hand.Config)) (*hand.Deck, error) {
+	deck, err := hand.VerifyDeck(r, options...)
+	if err != nil {
+		return nil, err
+	}
+	deck.Reduce(g.get().DeckOptions()...)
+	return deck, nil
+}
+
 func (t *Table) setUpHand() {
-	t.deck = t.dealer.Deck()
+	t.deck = t.newDeck()
 	t.round = 0
 	t.button = t.nextSeat(t.button+1, false)
 	t.action = -1
 	t.pot = newPot(t.NumOfSeats())
 	t.straddleSeats = []*StraddleSeat{}
+	t.drawing = false
+	t.voting = false
+	t.runs = 0
+	t.insuring = false
+	t.insurance = []*Insurance{}
+	t.settlements = nil
+	t.muck = []*hand.Card{}
 
 	// reset cards
-	t.board = []*hand.Card{}
+	t.boards = newBoards(t.opts)
 	t.Lock()
 	for _, player := range t.players {
 		player.holeCards = []*HoleCard{}
@@ -1044,12 +1353,13 @@ func (t *Table) setUpRound() {
 
 	t.updatePots()
 
-	// deal board cards
-	bCards := t.game().BoardCards(t.deck, round(t.round))
-	t.board = append(t.board, bCards...)
+	// deal board cards, after the draw in rounds with one
+	if t.drawRule().Max == 0 {
+		t.dealBoardCards()
+	}
 	t.resetActed()
 
-	relativePos := t.game().RoundStartSeat(t.HoleCards(), round(t.round))
+	relativePos := t.game().RoundStartSeat(t.HoleCards(), t.opts, round(t.round))
 	for seat, player := range t.players {
 		// add hole cards
 		hCards := t.game().HoleCards(t.deck, round(t.round))
@@ -1089,17 +1399,298 @@ func (t *Table) setUpRound() {
 	}
 
 	// if everyone is all in or out,  skip round
+	if t.numOfBettors() < 2 {
+		t.action = -1
+	}
+
+	// players draw or discard before the betting
+	if t.drawRule().Max > 0 {
+		t.startDraw()
+	}
+}
+
+// dealBoardCards deals the round's community cards to each board in
+// turn.
+func (t *Table) dealBoardCards() {
+	for i := range t.boards {
+		bCards := t.game().BoardCards(t.deck, round(t.round))
+		t.boards[i] = append(t.boards[i], bCards...)
+	}
+}
+
+// newBoards returns the empty boards of a hand.
+func newBoards(opts Config) [][]*hand.Card {
+	boards := [][]*hand.Card{{}}
+	if opts.Boards == 2 {
+		boards = append(boards, []*hand.Card{})
+	}
+	return boards
+}
+
+// doubleBoards returns the boards of a double board game for
+// serialization, or nil if the table has a single board.
+func (t *Table) doubleBoards() [][]*hand.Card {
+	if len(t.boards) < 2 {
+		return nil
+	}
+	return t.Boards()
+}
+
+// numOfBettors returns the number of players who are neither all in nor
+// out.
+func (t *Table) numOfBettors() int {
 	count := 0
 	for _, player := range t.players {
 		if !player.allin && !player.out {
 			count++
 		}
 	}
-	if count < 2 {
-		t.action = -1
+	return count
+}
+
+// startVote gives the action to the first player left after the button
+// to vote how many times to run the board when everyone left is all in
+// before the river.  It returns false if there is nothing to vote on.
+func (t *Table) startVote() bool {
+	if !t.showdown || t.runs != 0 || t.opts.MaxRuns < 2 || t.round+1 >= t.game().NumOfRounds() {
+		return false
+	}
+	t.resetActed()
+	t.voting = true
+	t.runs = t.opts.MaxRuns
+	t.action = t.nextVoteSeat(t.button + 1)
+	if t.action == -1 {
+		t.endVote()
+		return false
+	}
+	return true
+}
+
+// endVote copies the board for each run agreed to.  The rest of every
+// run is dealt from the same deck.
+func (t *Table) endVote() {
+	t.voting = false
+	t.action = -1
+	for len(t.boards) < t.runs {
+		t.boards = append(t.boards, append([]*hand.Card{}, t.boards[0]...))
 	}
 }
 
+// nextVoteSeat returns the next seat from seat of a player left in the
+// hand that hasn't voted, or -1 if everyone has.
+func (t *Table) nextVoteSeat(seat int) int {
+	t.RLock()
+	defer t.RUnlock()
+	for count := 0; count < t.NumOfSeats(); count++ {
+		s := (seat + count) % t.NumOfSeats()
+		p, ok := t.players[s]
+		if ok && !p.out && !p.stand && !p.acted {
+			return s
+		}
+	}
+	return -1
+}
+
+// handleVote lowers the runs to the player's vote.  A player who times
+// out votes to run it once.
+func (t *Table) handleVote(p *PlayerState, a Action, runs int, timeout bool) error {
+	if timeout {
+		runs = 1
+	} else if a != Run {
+		return ErrInvalidAction
+	} else if runs < 1 || runs > t.opts.MaxRuns {
+		return ErrInvalidRuns
+	}
+	if runs < t.runs {
+		t.runs = runs
+	}
+
+	player := p.Player()
+	playerAction := PlayerAction{
+		PlayerId:   player.ID(),
+		Action:     Run,
+		Chips:      runs,
+		ActionTime: time.Now().UTC(),
+		Timeout:    timeout,
+		RoundPot:   p.roundPot,
+		Pot:        p.pot,
+	}
+	player.SaveAction(t.Round(), playerAction)
+	return nil
+}
+
+// drawRule returns the draw or discard phase of the current round.
+func (t *Table) drawRule() drawRule {
+	return t.game().DrawRule(round(t.round))
+}
+
+// startDraw saves the betting action and gives the action to the first
+// player after the button who is still in the hand, including players
+// all in.  A card is burned before a draw.
+func (t *Table) startDraw() {
+	if t.drawRule().Replace {
+		t.muck = append(t.muck, t.drawCards(1)...)
+	}
+	t.betAction = t.action
+	t.drawing = true
+	t.action = t.nextDrawSeat(t.button + 1)
+	if t.action == -1 {
+		t.endDraw()
+	}
+}
+
+// endDraw deals the board cards and starts the betting of the round after
+// everyone has drawn or discarded.
+func (t *Table) endDraw() {
+	t.drawing = false
+	t.resetActed()
+	t.dealBoardCards()
+	t.action = t.betAction
+}
+
+// nextDrawSeat returns the next seat from seat that hasn't drawn, or -1
+// if everyone has.
+func (t *Table) nextDrawSeat(seat int) int {
+	t.RLock()
+	defer t.RUnlock()
+	for count := 0; count < t.NumOfSeats(); count++ {
+		s := (seat + count) % t.NumOfSeats()
+		p, ok := t.players[s]
+		if ok && !p.out && !p.stand && !p.acted {
+			return s
+		}
+	}
+	return -1
+}
+
+// handleDraw removes the player's discards and replaces them in draw
+// games.  A player who times out makes the default discard.
+func (t *Table) handleDraw(seat int, p *PlayerState, a Action, timeout bool) error {
+	rule := t.drawRule()
+	if a != rule.action() && !timeout {
+		return ErrInvalidAction
+	}
+
+	var discards []*hand.Card
+	if d, ok := p.player.(Drawer); ok && !timeout {
+		discards = d.Discards()
+	} else {
+		discards = t.defaultDiscards(p, rule)
+	}
+	if !t.validDiscards(p, discards, rule) {
+		return ErrInvalidDiscard
+	}
+	if rule.Replace && len(discards) > t.cardsLeft() {
+		return ErrInsufficientCards
+	}
+
+	discarded := hand.NewCardSet(discards...)
+	holeCards := []*HoleCard{}
+	for _, hc := range p.holeCards {
+		if !discarded.Contains(hc.Card) {
+			holeCards = append(holeCards, hc)
+		}
+	}
+	if rule.Replace {
+		for _, card := range t.drawCards(len(discards)) {
+			holeCards = append(holeCards, newHoleCard(card, Concealed))
+		}
+	}
+	p.holeCards = holeCards
+
+	// a player's own discards can't be dealt back to him or her
+	t.muck = append(t.muck, discards...)
+
+	player := p.Player()
+	playerAction := PlayerAction{
+		PlayerId:   player.ID(),
+		Action:     rule.action(),
+		Chips:      len(discards),
+		ActionTime: time.Now().UTC(),
+		Timeout:    timeout,
+		RoundPot:   p.roundPot,
+		Pot:        p.pot,
+	}
+	player.SaveAction(t.Round(), playerAction)
+	return nil
+}
+
+// defaultDiscards returns no cards if the player may stand pat, otherwise
+// the fewest cards that leave the best hand with the board.
+func (t *Table) defaultDiscards(p *PlayerState, rule drawRule) []*hand.Card {
+	if rule.Min == 0 {
+		return nil
+	}
+
+	cards := cardsFromHoleCards(p.holeCards)
+	var best *hand.Hand
+	var discards []*hand.Card
+	for _, combo := range util.Combinations(len(cards), rule.Min) {
+		discarded := hand.NewCardSet()
+		for _, i := range combo {
+			discarded = discarded.Add(cards[i])
+		}
+		kept := []*hand.Card{}
+		for _, card := range cards {
+			if !discarded.Contains(card) {
+				kept = append(kept, card)
+			}
+		}
+
+		h := t.game().FormHighHand(kept, t.Board())
+		if best == nil || (t.game().Sorting() == hand.SortingHigh && h.CompareTo(best) > 0) ||
+			(t.game().Sorting() == hand.SortingLow && h.CompareTo(best) < 0) {
+			best, discards = h, discarded.Cards()
+		}
+	}
+	return discards
+}
+
+// validDiscards returns true if the discards are different cards of the
+// player's hand and as many as the rule allows.
+func (t *Table) validDiscards(p *PlayerState, discards []*hand.Card, rule drawRule) bool {
+	if len(discards) < rule.Min || len(discards) > rule.Max {
+		return false
+	}
+	held := hand.NewCardSet(cardsFromHoleCards(p.holeCards)...)
+	for _, card := range discards {
+		if card == nil || !held.Contains(card) {
+			return false
+		}
+		held = held.Remove(card)
+	}
+	return true
+}
+
+// cardsLeft returns the number of cards that can still be drawn from the
+// stub and the discards.
+func (t *Table) cardsLeft() int {
+	return len(t.deck.Cards) + len(t.muck)
+}
+
+// drawCards pops n cards, or as many as are left, from the deck.  When
+// the stub runs out the discards and burn cards are shuffled into a new
+// stub by the dealer if it is a hand.Shuffler.
+func (t *Table) drawCards(n int) []*hand.Card {
+	cards := []*hand.Card{}
+	for len(cards) < n {
+		if len(t.deck.Cards) == 0 {
+			if len(t.muck) == 0 {
+				break
+			}
+			t.deck.Cards = t.muck
+			t.muck = []*hand.Card{}
+			if s, ok := t.dealer.(hand.Shuffler); ok {
+				s.Shuffle(t.deck)
+			} else {
+				t.deck.Shuffle(hand.CryptoRNG())
+			}
+		}
+		cards = append(cards, t.deck.Pop())
+	}
+	return cards
+}
+
 func (t *Table) payoutResults(resultsMap map[int][]*Result) {
 	t.Lock()
 	defer t.Unlock()
@@ -1114,7 +1705,8 @@ func (t *Table) payoutResults(resultsMap map[int][]*Result) {
 }
 
 func (t *Table) ShowBoardCards(r int) (cards []*hand.Card) {
-	if r < t.round {
+	// the deck isn't dealt until the hand starts
+	if r < t.round || !t.startedHand {
 		return
 	}
 	switch round(r) {
@@ -1286,10 +1878,10 @@ func (t *Table) AutoConcealedHoleCards(seat int, results map[int][]*Result) []in
 	}
 	// 手牌比前位秀牌玩家的弱自动埋牌
 	handCard := cardsFromHoleCards(array[0].holeCards)
-	target := t.game().FormHighHand(handCard, t.board)
+	target := t.game().FormHighHand(handCard, t.Board())
 	for _, p := range array {
 		handCard = cardsFromHoleCards(p.holeCards)
-		highHand := t.game().FormHighHand(handCard, t.board)
+		highHand := t.game().FormHighHand(handCard, t.Board())
 		if highHand.CompareTo(target) < 0 {
 			for _, card := range p.holeCards {
 				card.Visibility = Concealed
@@ -1424,8 +2016,8 @@ func (t *Table) HoleCardsBySeats(seats []int) map[int][]*HoleCard {
 }
 
 func (t *Table) GetLeadingPlayer(holeCards map[int][]*hand.Card) Hands {
-	highHands := newHands(holeCards, t.board, t.game().FormHighHand)
-	lowHands := newHands(holeCards, t.board, t.game().FormLowHand)
+	highHands := newHands(holeCards, t.Board(), t.game().FormHighHand)
+	lowHands := newHands(holeCards, t.Board(), t.formLowHand)
 	sideHighHands := highHands.handsForSeats(t.pot.seats())
 	sideLowHands := lowHands.handsForSeats(t.pot.seats())
 
@@ -1489,83 +2081,54 @@ func (t *Table) MaxPotLeadingPlayerForInsurance() Hands {
 // leadingHoleCards: 领先玩家手牌
 // backwardHoleCards: 落后玩家手牌
 // board: 公共牌
+// 两张手牌按 hand.AnyTwo 计算，更多手牌按 Omaha 计算；
+// 其他游戏（如 Pineapple）请使用 CalcOutsWithRule
 func CalcOuts(leadingHoleCards []*hand.Card, backwardHoleCards [][]*hand.Card, board []*hand.Card, excludedBoard bool) (outs []*hand.Card) {
+	rule := hand.AnyTwo
+	if len(leadingHoleCards) > 2 {
+		rule = hand.Omaha(len(leadingHoleCards))
+	}
+	return CalcOutsWithRule(rule, leadingHoleCards, backwardHoleCards, board, excludedBoard)
+}
+
+// 按游戏的手牌规则计算保险 outs
+// rule: 游戏的手牌规则，如 hand.AnyTwo 或 hand.Omaha(4)
+func CalcOutsWithRule(rule hand.HoleCardRule, leadingHoleCards []*hand.Card, backwardHoleCards [][]*hand.Card, board []*hand.Card, excludedBoard bool) (outs []*hand.Card) {
 	// 不计入 OUTS 的牌
-	excludedCards := []*hand.Card{}
-	excludedCards = append(excludedCards, leadingHoleCards...)
+	excludedCards := hand.NewCardSet(leadingHoleCards...)
 	if !excludedBoard {
-		excludedCards = append(excludedCards, board...)
+		excludedCards = excludedCards.Union(hand.NewCardSet(board...))
 	}
 
 	for _, item := range backwardHoleCards {
-		excludedCards = append(excludedCards, item...)
+		excludedCards = excludedCards.Union(hand.NewCardSet(item...))
 	}
 
 	cards := hand.CardsOrderByRank()
-	isOmaha := false
-	if len(leadingHoleCards) == 4 {
-		isOmaha = true
-	}
-	opts := func(c *hand.Config) {}
 
 	calcOuts := []*hand.Card{}
 
 	for _, card := range cards {
-		excluded := false
-		for _, eCard := range excludedCards {
-			if card.Rank() == eCard.Rank() && card.Suit() == eCard.Suit() {
-				excluded = true
-				break
-			}
-
-		}
-		if excluded {
+		if excludedCards.Contains(card) {
 			continue
 		}
 
+		boardCards := append(append([]*hand.Card{}, board...), card)
+		newLeadingHand := rule.Hand(leadingHoleCards, boardCards)
 		for _, backward := range backwardHoleCards {
-			if isOmaha {
-				boardCards := board
-				boardCards = append(boardCards, card)
-				newBackwardhands := omahaHands(backward, boardCards, opts)
-				newBackwardhands = hand.Sort(hand.SortingHigh, hand.DESC, newBackwardhands...)
-				newLeadingHands := omahaHands(leadingHoleCards, boardCards, opts)
-				newLeadingHands = hand.Sort(hand.SortingHigh, hand.DESC, newLeadingHands...)
-
-				if newBackwardhands[0].CompareTo(newLeadingHands[0]) > 0 {
-					calcOuts = append(calcOuts, card)
-				}
-
-			} else {
-				newBackwardCards := []*hand.Card{card}
-				newBackwardCards = append(newBackwardCards, board...)
-				newBackwardCards = append(newBackwardCards, backward...)
-				newBackwardHand := hand.New(newBackwardCards)
-
-				newLeadingCards := []*hand.Card{card}
-				newLeadingCards = append(newLeadingCards, board...)
-				newLeadingCards = append(newLeadingCards, leadingHoleCards...)
-				newLeadingHand := hand.New(newLeadingCards)
-
-				compareTo := newBackwardHand.CompareTo(newLeadingHand)
-				if compareTo > 0 {
-					calcOuts = append(calcOuts, card)
-				}
+			newBackwardHand := rule.Hand(backward, boardCards)
+			if newBackwardHand.CompareTo(newLeadingHand) > 0 {
+				calcOuts = append(calcOuts, card)
 			}
 		}
 	}
 
 	// 对 calcOuts 去重
-	for i := 0; i < len(calcOuts); i++ {
-		exists := false
-		for v := 0; v < i; v++ {
-			if calcOuts[v] == calcOuts[i] {
-				exists = true
-				break
-			}
-		}
-		if !exists {
-			outs = append(outs, calcOuts[i])
+	found := hand.CardSet(0)
+	for _, card := range calcOuts {
+		if !found.Contains(card) {
+			outs = append(outs, card)
+			found = found.Add(card)
 		}
 	}
 
@@ -1615,6 +2178,37 @@ func (t *Table) isNobodyCanPlay() bool {
 	return count < 2 && actedCount == total
 }
 
+// formLowHand forms the low hand with the table's qualifier.
+func (t *Table) formLowHand(holeCards []*hand.Card, board []*hand.Card) *hand.Hand {
+	return t.game().FormLowHand(holeCards, board, t.Qualifier())
+}
+
+// recordRuns moves the board number of the results to the run number
+// when the board was run more than once.
+func (t *Table) recordRuns(results map[int][]*Result) {
+	if t.runs < 2 {
+		return
+	}
+	for _, rs := range results {
+		for _, r := range rs {
+			r.Run, r.Board = r.Board, 0
+		}
+	}
+}
+
+// recordQualifier records the qualifier in the results of split pot games
+// so that a pot without a low can be explained.
+func (t *Table) recordQualifier(results map[int][]*Result) {
+	if !t.game().SplitPot() {
+		return
+	}
+	for _, rs := range results {
+		for _, r := range rs {
+			r.Qualifier = t.Qualifier()
+		}
+	}
+}
+
 func (t *Table) game() game {
 	return t.opts.Game.get()
 }
@@ -1624,6 +2218,11 @@ func (t *Table) setBlindSeat(seat, pos int) {
 	if round(t.round) != preflop {
 		return
 	}
+	if t.opts.AnteOnly {
+		t.smallBetSeat = -1
+		t.bigBetSeat = -1
+		return
+	}
 
 	if len(t.HoleCards()) == 2 {
 		switch pos {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/rolends1986/poker/hand"
@@ -35,6 +36,7 @@ type TestPlayer struct {
 	country  string
 	actions  []PlayerAction
 	index    int
+	discards []func(holeCards []*hand.Card) []*hand.Card
 	stand    bool
 	hosted   bool
 	tbl      *table.Table
@@ -60,6 +62,36 @@ func (p *TestPlayer) Raise(amount int) {
 	p.actions = append(p.actions, PlayerAction{table.Raise, amount})
 }
 
+// Run queues a vote to run the rest of the board the number of times.
+func (p *TestPlayer) Run(runs int) {
+	p.actions = append(p.actions, PlayerAction{table.Run, runs})
+}
+
+// Draw queues a draw of the cards chosen by discard from the player's
+// hole cards at the time of the draw.
+func (p *TestPlayer) Draw(discard func(holeCards []*hand.Card) []*hand.Card) {
+	p.actions = append(p.actions, PlayerAction{table.Draw, 0})
+	p.discards = append(p.discards, discard)
+}
+
+// Discard queues a discard of the cards chosen by discard without
+// replacement.
+func (p *TestPlayer) Discard(discard func(holeCards []*hand.Card) []*hand.Card) {
+	p.actions = append(p.actions, PlayerAction{table.Discard, 0})
+	p.discards = append(p.discards, discard)
+}
+
+func (p *TestPlayer) Discards() []*hand.Card {
+	discard := p.discards[0]
+	p.discards = p.discards[1:]
+	for _, state := range p.tbl.Players() {
+		if state.Player().ID() == p.id {
+			return discard(cardsOf(state.HoleCards()))
+		}
+	}
+	return nil
+}
+
 func (p *TestPlayer) ID() int64 {
 	return p.id
 }
@@ -298,8 +330,8 @@ func TestRaises(t *testing.T) {
 	p4.Check()
 	p1.Bet(48)
 	p2.Call()
-	p3.Raise(2)
-	p4.Raise(8)
+	p3.Raise(50)
+	p4.Raise(58)
 
 	for i := 0; i < 12; i++ {
 		if _, _, err := tbl.Next(); err != nil {
@@ -455,6 +487,7 @@ func TestTable_ShowBoardCards(t *testing.T) {
 }
 
 type testCalcOuts struct {
+	rule              hand.HoleCardRule
 	leadingHoleCards  []*hand.Card
 	backwardHoleCards [][]*hand.Card
 	board             []*hand.Card
@@ -463,6 +496,7 @@ type testCalcOuts struct {
 
 var calOutsTests = []testCalcOuts{
 	{
+		hand.AnyTwo,
 		pokertest.Cards("Td", "5s"),
 		[][]*hand.Card{
 			pokertest.Cards("8s", "Qs"),
@@ -471,7 +505,7 @@ var calOutsTests = []testCalcOuts{
 		pokertest.Cards("Qh", "Qc", "Qd", "8c", "8d"),
 	},
 	{
-
+		hand.ExactlyTwo,
 		pokertest.Cards("5s", "Qc", "3c", "Js"),
 		[][]*hand.Card{
 			pokertest.Cards("Ac", "4s", "4c", "9c"),
@@ -480,6 +514,7 @@ var calOutsTests = []testCalcOuts{
 		pokertest.Cards("As", "Ah", "Ad", "9h", "9d", "4h", "4d", "2s", "2h", "2c", "2d"),
 	},
 	{
+		hand.ExactlyTwo,
 		pokertest.Cards("8d", "3d", "Jh", "3c"),
 		[][]*hand.Card{
 			pokertest.Cards("8h", "6c", "Qs", "8s"),
@@ -489,6 +524,7 @@ var calOutsTests = []testCalcOuts{
 		pokertest.Cards("Ts", "Th", "Tc", "Td", "8c", "6d"),
 	},
 	{
+		hand.AnyTwo,
 		pokertest.Cards("Ac", "7c"),
 		[][]*hand.Card{
 			pokertest.Cards("Td", "4h"),
@@ -498,6 +534,25 @@ var calOutsTests = []testCalcOuts{
 		pokertest.Cards("Kd", "Kh", "5s"),
 		pokertest.Cards("Qh", "Qc", "Qd", "Ts", "Th", "Tc", "6s", "6h", "4s", "4c", "4d"),
 	},
+	{
+		// three hole cards before the Pineapple discard play any two
+		hand.AnyTwo,
+		pokertest.Cards("Ah", "Ac", "2h"),
+		[][]*hand.Card{
+			pokertest.Cards("7s", "7d", "8c"),
+		},
+		pokertest.Cards("Kh", "9c", "4d", "3s"),
+		pokertest.Cards("8s", "8h", "8d", "7h", "7c"),
+	},
+	{
+		hand.Omaha(3),
+		pokertest.Cards("Ah", "Ac", "2h"),
+		[][]*hand.Card{
+			pokertest.Cards("7s", "7d", "8c"),
+		},
+		pokertest.Cards("Kh", "9c", "4d", "3s"),
+		pokertest.Cards("7h", "7c"),
+	},
 }
 
 func TestCalcOuts(t *testing.T) {
@@ -506,7 +561,7 @@ func TestCalcOuts(t *testing.T) {
 		t.Log("backwardHoleCards: ", test.backwardHoleCards)
 		t.Log("board: ", test.board)
 
-		outs := table.CalcOuts(test.leadingHoleCards, test.backwardHoleCards, test.board)
+		outs := table.CalcOutsWithRule(test.rule, test.leadingHoleCards, test.backwardHoleCards, test.board, false)
 		t.Logf("outs: %v", outs)
 
 		o1, _ := json.Marshal(outs)
@@ -515,6 +570,17 @@ func TestCalcOuts(t *testing.T) {
 			t.Errorf("calc outs error: %v != %v", outs, test.outs)
 		}
 	}
+
+	// CalcOuts infers the rule from the number of hole cards
+	for _, i := range []int{0, 1, 5} {
+		test := calOutsTests[i]
+		outs := table.CalcOuts(test.leadingHoleCards, test.backwardHoleCards, test.board, false)
+		o1, _ := json.Marshal(outs)
+		o2, _ := json.Marshal(test.outs)
+		if string(o1) != string(o2) {
+			t.Errorf("CalcOuts() = %v; want %v", outs, test.outs)
+		}
+	}
 }
 
 func TestLeadingPlayer(t *testing.T) {
@@ -573,3 +639,501 @@ func TestLeadingPlayer(t *testing.T) {
 		}
 	}
 }
+
+func TestProvablyFairHand(t *testing.T) {
+	t.Parallel()
+	register()
+
+	for _, game := range []table.Game{table.Holdem, table.RoyalHoldem} {
+		opts := table.Config{
+			Game: game,
+			Stakes: table.Stakes{
+				SmallBet: 1,
+				BigBet:   2,
+			},
+			NumOfSeats: 6,
+			Limit:      table.NoLimit,
+		}
+		p1 := Player(1, []PlayerAction{})
+		p2 := Player(2, []PlayerAction{})
+		dealer := hand.NewFairDealer()
+		tbl := table.New(opts, dealer)
+		if err := tbl.Sit(p1, 0, 100, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := tbl.Sit(p2, 1, 100, false); err != nil {
+			t.Fatal(err)
+		}
+
+		// the commitment is published before the client seeds are added
+		published := tbl.Commitment()
+		if published != dealer.Commitment() {
+			t.Fatalf("Commitment() = %q; want the dealer's next %q", published, dealer.Commitment())
+		}
+		dealer.AddClientSeed("alice")
+		dealer.AddClientSeed("bob")
+
+		p1.Fold()
+		p2.Fold()
+		if _, _, err := tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+		if tbl.Commitment() != published {
+			t.Fatalf("Commitment() = %q; want %q", tbl.Commitment(), published)
+		}
+		if tbl.Reveal() != nil {
+			t.Fatal("Reveal() should be nil during the hand")
+		}
+
+		// a table saved during the hand keeps the whole reveal, but its
+		// views only show the commitment
+		saved, err := json.Marshal(tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reloaded := &table.Table{}
+		if err := json.Unmarshal(saved, reloaded); err != nil {
+			t.Fatal(err)
+		}
+		if reloaded.Commitment() != published || reloaded.Reveal() != nil {
+			t.Fatalf("reloaded Commitment() = %q, Reveal() = %v; want %q and nil", reloaded.Commitment(), reloaded.Reveal(), published)
+		}
+		resaved, err := json.Marshal(reloaded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		view, err := json.Marshal(tbl.LookerView())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for tbl.StartedHand() {
+			if _, _, err := tbl.Next(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		r := tbl.Reveal()
+		if r == nil {
+			t.Fatal("Reveal() should not be nil after the hand")
+		}
+		if r.Commitment != published || len(r.ClientSeeds) != 2 {
+			t.Fatalf("Reveal() = %+v; want the published commitment and two client seeds", r)
+		}
+		if !strings.Contains(string(resaved), r.ServerSeed) {
+			t.Fatal("a table reloaded during the hand lost the server seed")
+		}
+		if strings.Contains(string(view), r.ServerSeed) {
+			t.Fatal("a view of the table during the hand shows the server seed")
+		}
+		deck, err := table.VerifyDeck(game, *r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dealt := hand.NewCardSet(deck.Cards[len(deck.Cards)-4:]...)
+		for seat, player := range tbl.Players() {
+			for _, hc := range player.HoleCards() {
+				if !dealt.Contains(hc.Card) {
+					t.Fatalf("%s seat %d's hole card %v isn't in the verified deck's first cards", game, seat, hc.Card)
+				}
+			}
+		}
+	}
+}
+
+func TestDrawGame(t *testing.T) {
+	t.Parallel()
+
+	opts := table.Config{
+		Game: table.DeuceToSevenSingleDraw,
+		Stakes: table.Stakes{
+			SmallBet: 1,
+			BigBet:   2,
+		},
+		NumOfSeats: 6,
+		Limit:      table.NoLimit,
+	}
+	p1 := Player(1, []PlayerAction{})
+	p2 := Player(2, []PlayerAction{})
+	tbl := table.New(opts, hand.NewDealer())
+	p1.tbl, p2.tbl = tbl, tbl
+	if err := tbl.Sit(p1, 0, 100, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// predraw
+	p2.Call()
+	p1.Check()
+
+	// seat 0 draws two, seat 1 discards a card it doesn't hold then
+	// stands pat
+	var discards []*hand.Card
+	p1.Draw(func(holeCards []*hand.Card) []*hand.Card {
+		discards = []*hand.Card{holeCards[0], holeCards[3]}
+		return discards
+	})
+	p2.Draw(func(holeCards []*hand.Card) []*hand.Card {
+		held := hand.NewCardSet(holeCards...)
+		return hand.NewCardSet(hand.Cards()...).Difference(held).Cards()[:1]
+	})
+	p2.Draw(func(holeCards []*hand.Card) []*hand.Card {
+		return nil
+	})
+
+	// after the draw
+	p1.Check()
+	p2.Check()
+
+	for i := 0; i < 4; i++ {
+		if _, _, err := tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !tbl.Drawing() || tbl.Action() != 0 {
+		t.Fatalf("Drawing() = %v with action on %d; want seat 0 drawing", tbl.Drawing(), tbl.Action())
+	}
+	if actions := tbl.ValidActions(); len(actions) != 1 || actions[0] != table.Draw {
+		t.Fatalf("ValidActions() = %v; want Draw", actions)
+	}
+
+	if _, _, err := tbl.Next(); err != nil {
+		t.Fatal(err)
+	}
+	after := hand.NewCardSet(cardsOf(tbl.Player(0).HoleCards())...)
+	if after.Count() != 5 || after.Contains(discards[0]) || after.Contains(discards[1]) {
+		t.Fatalf("hole cards after drawing %v = %v", discards, tbl.Player(0).HoleCards())
+	}
+
+	if _, _, err := tbl.Next(); err != table.ErrInvalidDiscard {
+		t.Fatalf("Next() error = %v; want %v", err, table.ErrInvalidDiscard)
+	}
+	if _, _, err := tbl.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if tbl.Drawing() || tbl.Action() != 0 {
+		t.Fatalf("Drawing() = %v with action on %d; want seat 0 betting", tbl.Drawing(), tbl.Action())
+	}
+
+	var results map[int][]*table.Result
+	for tbl.StartedHand() {
+		var err error
+		if results, _, err = tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(results) == 0 {
+		t.Fatal("the hand should be paid out at the showdown")
+	}
+}
+
+func TestPineapple(t *testing.T) {
+	t.Parallel()
+	register()
+
+	for _, g := range []table.Game{table.Pineapple, table.CrazyPineapple} {
+		opts := table.Config{
+			Game: g,
+			Stakes: table.Stakes{
+				SmallBet: 1,
+				BigBet:   2,
+			},
+			NumOfSeats: 6,
+			Limit:      table.NoLimit,
+		}
+		p1 := Player(1, []PlayerAction{})
+		p2 := Player(2, []PlayerAction{})
+		tbl := table.New(opts, hand.NewDealer())
+		p1.tbl, p2.tbl = tbl, tbl
+		if err := tbl.Sit(p1, 0, 100, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := tbl.Sit(p2, 1, 100, false); err != nil {
+			t.Fatal(err)
+		}
+
+		var discarded []*hand.Card
+		discardFirst := func(holeCards []*hand.Card) []*hand.Card {
+			discarded = append(discarded, holeCards[0])
+			return holeCards[:1]
+		}
+		discardTwo := func(holeCards []*hand.Card) []*hand.Card {
+			return holeCards[:2]
+		}
+
+		// the button calls and everyone checks to the showdown, seat 1
+		// tries to discard two cards first
+		discard := func() {
+			p1.Discard(discardFirst)
+			p2.Discard(discardTwo)
+			p2.Discard(discardFirst)
+		}
+		if g == table.Pineapple {
+			discard()
+		}
+		p2.Call()
+		p1.Check()
+		p1.Check()
+		p2.Check()
+		if g == table.CrazyPineapple {
+			discard()
+		}
+		for i := 0; i < 2; i++ {
+			p1.Check()
+			p2.Check()
+		}
+
+		var results map[int][]*table.Result
+		for tbl.StartedHand() || results == nil {
+			var err error
+			results, _, err = tbl.Next()
+			if err == table.ErrInvalidDiscard {
+				continue
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if tbl.Drawing() {
+				if g == table.CrazyPineapple && len(tbl.Board()) != 3 {
+					t.Fatalf("%v discards with board %v; want the flop", g, tbl.Board())
+				}
+				if actions := tbl.ValidActions(); len(actions) != 1 || actions[0] != table.Discard {
+					t.Fatalf("%v ValidActions() = %v; want Discard", g, actions)
+				}
+			}
+
+			for _, view := range []*table.Table{tbl.View(p1), tbl.View(p2), tbl.LookerView()} {
+				b, err := json.Marshal(view)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, card := range discarded {
+					text, _ := card.MarshalText()
+					if strings.Contains(string(b), string(text)) {
+						t.Fatalf("%v view shows discarded card %v: %s", g, card, b)
+					}
+				}
+			}
+		}
+
+		if len(discarded) != 2 {
+			t.Fatalf("%v discarded %v; want a card from each player", g, discarded)
+		}
+		for seat, player := range tbl.Players() {
+			if n := len(player.HoleCards()); n != 2 {
+				t.Fatalf("%v seat %d has %d hole cards; want 2", g, seat, n)
+			}
+		}
+	}
+}
+
+func cardsOf(holeCards []*table.HoleCard) []*hand.Card {
+	cards := []*hand.Card{}
+	for _, hc := range holeCards {
+		cards = append(cards, hc.Card)
+	}
+	return cards
+}
+
+func TestDoubleBoard(t *testing.T) {
+	t.Parallel()
+
+	opts := table.Config{
+		Game: table.Holdem,
+		Stakes: table.Stakes{
+			SmallBet: 1,
+			BigBet:   2,
+		},
+		NumOfSeats: 6,
+		Limit:      table.NoLimit,
+		Boards:     2,
+	}
+	p1 := Player(1, []PlayerAction{})
+	p2 := Player(2, []PlayerAction{})
+	tbl := table.New(opts, hand.NewDealer())
+	if err := tbl.Sit(p1, 0, 100, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// both players are all in preflop
+	p2.Raise(100)
+	p1.Call()
+
+	var results map[int][]*table.Result
+	for results == nil {
+		var err error
+		if results, _, err = tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	boards := tbl.Boards()
+	if len(boards) != 2 || len(boards[0]) != 5 || len(boards[1]) != 5 {
+		t.Fatalf("Boards() = %v; want two boards of five cards", boards)
+	}
+	if hand.NewCardSet(append(boards[0], boards[1]...)...).Count() != 10 {
+		t.Fatalf("Boards() = %v; want different cards on each board", boards)
+	}
+
+	won := map[int]int{}
+	for _, rs := range results {
+		for _, r := range rs {
+			won[r.Board] += r.Chips
+		}
+	}
+	if len(won) != 2 || won[1] != 100 || won[2] != 100 {
+		t.Fatalf("chips won by board = %v; want 100 on each board", won)
+	}
+}
+
+func TestDoubleBoardPanics(t *testing.T) {
+	t.Parallel()
+
+	for _, opts := range []table.Config{
+		{Game: table.StudHi, NumOfSeats: 6, Boards: 2},
+		{Game: table.OmahaHi5, NumOfSeats: 9, Boards: 2},
+		{Game: table.Holdem, NumOfSeats: 6, Boards: 3},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New(%+v) should panic", opts)
+				}
+			}()
+			table.New(opts, hand.NewDealer())
+		}()
+	}
+}
+
+func TestRunItTwice(t *testing.T) {
+	t.Parallel()
+
+	opts := table.Config{
+		Game: table.Holdem,
+		Stakes: table.Stakes{
+			SmallBet: 1,
+			BigBet:   2,
+		},
+		NumOfSeats: 6,
+		Limit:      table.NoLimit,
+		MaxRuns:    3,
+	}
+	p1 := Player(1, []PlayerAction{})
+	p2 := Player(2, []PlayerAction{})
+	tbl := table.New(opts, hand.NewDealer())
+	if err := tbl.Sit(p1, 0, 100, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// both players are all in preflop, seat 0 votes for too many runs
+	// and then three, seat 1 votes for two
+	p2.Raise(100)
+	p1.Call()
+	p1.Run(4)
+	p1.Run(3)
+	p2.Run(2)
+
+	var results map[int][]*table.Result
+	invalid := 0
+	for results == nil {
+		var err error
+		results, _, err = tbl.Next()
+		if err == table.ErrInvalidRuns {
+			invalid++
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tbl.Voting() {
+			if actions := tbl.ValidActions(); len(actions) != 1 || actions[0] != table.Run {
+				t.Fatalf("ValidActions() = %v; want Run", actions)
+			}
+		}
+	}
+
+	if invalid != 1 {
+		t.Fatalf("%d invalid votes; want 1", invalid)
+	}
+	if tbl.Runs() != 2 {
+		t.Fatalf("Runs() = %d; want 2", tbl.Runs())
+	}
+	boards := tbl.Boards()
+	if len(boards) != 2 || len(boards[0]) != 5 || len(boards[1]) != 5 {
+		t.Fatalf("Boards() = %v; want two runs of five cards", boards)
+	}
+	if hand.NewCardSet(append(boards[0], boards[1]...)...).Count() != 10 {
+		t.Fatalf("Boards() = %v; want each run dealt from the same deck", boards)
+	}
+
+	won := map[int]int{}
+	for _, rs := range results {
+		for _, r := range rs {
+			won[r.Run] += r.Chips
+		}
+	}
+	if len(won) != 2 || won[1] != 100 || won[2] != 100 {
+		t.Fatalf("chips won by run = %v; want 100 on runs 1 and 2", won)
+	}
+}
+
+func TestRunItTwiceOnTheFlop(t *testing.T) {
+	t.Parallel()
+
+	opts := table.Config{
+		Game: table.Holdem,
+		Stakes: table.Stakes{
+			SmallBet: 1,
+			BigBet:   2,
+		},
+		NumOfSeats: 6,
+		Limit:      table.NoLimit,
+		MaxRuns:    2,
+	}
+	p1 := Player(1, []PlayerAction{})
+	p2 := Player(2, []PlayerAction{})
+	tbl := table.New(opts, hand.NewDealer())
+	if err := tbl.Sit(p1, 0, 100, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tbl.Sit(p2, 1, 100, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// both players are all in on the flop and vote to run it twice
+	p2.Call()
+	p1.Check()
+	p1.Bet(98)
+	p2.Call()
+	p1.Run(2)
+	p2.Run(2)
+
+	var results map[int][]*table.Result
+	for results == nil {
+		var err error
+		if results, _, err = tbl.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	boards := tbl.Boards()
+	if len(boards) != 2 || len(boards[0]) != 5 || len(boards[1]) != 5 {
+		t.Fatalf("Boards() = %v; want two runs of five cards", boards)
+	}
+	for i := 0; i < 3; i++ {
+		if boards[0][i] != boards[1][i] {
+			t.Fatalf("Boards() = %v; want both runs to share the flop", boards)
+		}
+	}
+	if hand.NewCardSet(append(boards[0], boards[1]...)...).Count() != 7 {
+		t.Fatalf("Boards() = %v; want the turn and river run twice", boards)
+	}
+}